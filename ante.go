@@ -0,0 +1,52 @@
+package app
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge"
+)
+
+// NewAnteHandler wraps the auth AnteHandler for the bridge. Claims that are not signed by a bonded validator or its
+// registered feeder are rejected before any fees are charged, and transactions made up only of legitimate claims
+// are exempt from the node's minimum gas prices so relayer accounts do not need to be funded.
+func NewAnteHandler(ak auth.AccountKeeper, fck auth.FeeCollectionKeeper, ethBridgeKeeper ethbridge.Keeper) sdk.AnteHandler {
+	authAnteHandler := auth.NewAnteHandler(ak, fck)
+	return func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, sdk.Result, bool) {
+		claimsOnly, err := validateBridgeClaims(ctx, ethBridgeKeeper, tx.GetMsgs())
+		if err != nil {
+			// Set a gas meter with limit 0 like the auth AnteHandler does for the txs it rejects outright
+			return auth.SetGasMeter(simulate, ctx, 0), err.Result(), true
+		}
+		if claimsOnly {
+			ctx = ctx.WithMinGasPrices(sdk.DecCoins{})
+		}
+		return authAnteHandler(ctx, tx, simulate)
+	}
+}
+
+// validateBridgeClaims checks the signer of every bridge claim, claim batch and attestation signature in a
+// transaction and returns whether the transaction contains nothing but those
+func validateBridgeClaims(ctx sdk.Context, ethBridgeKeeper ethbridge.Keeper, msgs []sdk.Msg) (bool, sdk.Error) {
+	claimsOnly := len(msgs) > 0
+	for _, msg := range msgs {
+		var err sdk.Error
+		switch msg := msg.(type) {
+		case ethbridge.MsgMakeEthBridgeClaim:
+			err = ethBridgeKeeper.ValidateClaimSigner(ctx, msg.Validator, msg.Feeder)
+		case ethbridge.MsgMakeEthBridgeClaims:
+			err = ethBridgeKeeper.ValidateClaimSigner(ctx, msg.Validator(), msg.Feeder)
+		case ethbridge.MsgMakeEthBridgeProvenClaim:
+			err = ethBridgeKeeper.ValidateClaimSigner(ctx, msg.Validator, msg.Feeder)
+		case ethbridge.MsgMakeEthBridgeReleaseClaim:
+			err = ethBridgeKeeper.ValidateClaimSigner(ctx, msg.Validator, msg.Feeder)
+		case ethbridge.MsgSignAttestation:
+			err = ethBridgeKeeper.ValidateClaimSigner(ctx, sdk.AccAddress(msg.Validator), msg.Feeder)
+		default:
+			claimsOnly = false
+		}
+		if err != nil {
+			return false, err
+		}
+	}
+	return claimsOnly, nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle"
 
 	bam "github.com/cosmos/cosmos-sdk/baseapp"
@@ -31,6 +32,8 @@ type ethereumBridgeApp struct {
 	keyStaking       *sdk.KVStoreKey
 	tkeyStaking      *sdk.TransientStoreKey
 	keyOracle        *sdk.KVStoreKey
+	keyEthBridge     *sdk.KVStoreKey
+	keyEthHeaders    *sdk.KVStoreKey
 	keyFeeCollection *sdk.KVStoreKey
 	keyParams        *sdk.KVStoreKey
 	tkeyParams       *sdk.TransientStoreKey
@@ -40,8 +43,12 @@ type ethereumBridgeApp struct {
 	feeCollectionKeeper auth.FeeCollectionKeeper
 	stakingKeeper       staking.Keeper
 
-	paramsKeeper params.Keeper
-	oracleKeeper oracle.Keeper
+	paramsKeeper     params.Keeper
+	oracleKeeper     oracle.Keeper
+	ethBridgeKeeper  ethbridge.Keeper
+	ethHeadersKeeper ethheaders.Keeper
+
+	invariants invariantRegistry
 }
 
 // NewEthereumBridgeApp is a constructor function for ethereumBridgeApp
@@ -63,6 +70,8 @@ func NewEthereumBridgeApp(logger log.Logger, db dbm.DB) *ethereumBridgeApp {
 		keyStaking:       sdk.NewKVStoreKey(staking.StoreKey),
 		tkeyStaking:      sdk.NewTransientStoreKey(staking.TStoreKey),
 		keyOracle:        sdk.NewKVStoreKey(oracle.StoreKey),
+		keyEthBridge:     sdk.NewKVStoreKey(ethbridge.StoreKey),
+		keyEthHeaders:    sdk.NewKVStoreKey(ethheaders.StoreKey),
 		keyFeeCollection: sdk.NewKVStoreKey(auth.FeeStoreKey),
 		keyParams:        sdk.NewKVStoreKey(params.StoreKey),
 		tkeyParams:       sdk.NewTransientStoreKey(params.TStoreKey),
@@ -110,21 +119,52 @@ func NewEthereumBridgeApp(logger log.Logger, db dbm.DB) *ethereumBridgeApp {
 	}
 	app.oracleKeeper = oracleKeeper
 
+	// The EthHeadersKeeper is the Keeper from the ethheaders module
+	// It keeps the ethereum headers submitted to the light client and verifies receipt proofs against them
+	app.ethHeadersKeeper = ethheaders.NewKeeper(
+		app.oracleKeeper,
+		app.stakingKeeper,
+		app.keyEthHeaders,
+		app.paramsKeeper.Subspace(ethheaders.DefaultParamspace),
+		app.cdc,
+		ethheaders.DefaultCodespace,
+	)
+
+	// The EthBridgeKeeper is the Keeper from the ethbridge module
+	// It forwards ethereum claims to the oracle, mints the coins of successful claims and pays the bridge fees
+	// to the validators that attested to them
+	app.ethBridgeKeeper = ethbridge.NewKeeper(
+		app.oracleKeeper,
+		app.bankKeeper,
+		app.stakingKeeper,
+		app.ethHeadersKeeper,
+		app.keyEthBridge,
+		app.paramsKeeper.Subspace(ethbridge.DefaultParamspace),
+		app.cdc,
+		ethbridge.DefaultCodespace,
+	)
+
+	// Register the invariants asserted at runtime by the EndBlocker
+	bank.RegisterInvariants(&app.invariants, app.accountKeeper)
+	ethbridge.RegisterInvariants(&app.invariants, app.ethBridgeKeeper)
+
 	// The AnteHandler handles signature verification and transaction pre-processing
-	app.SetAnteHandler(auth.NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper))
+	app.SetAnteHandler(NewAnteHandler(app.accountKeeper, app.feeCollectionKeeper, app.ethBridgeKeeper))
 
 	// The app.Router is the main transaction router where each module registers its routes
 	// Register the bank route here
 	app.Router().
 		AddRoute(bank.RouterKey, bank.NewHandler(app.bankKeeper)).
 		AddRoute(staking.RouterKey, staking.NewHandler(app.stakingKeeper)).
-		AddRoute(ethbridge.RouterKey, ethbridge.NewHandler(app.oracleKeeper, app.bankKeeper, app.cdc, ethbridge.DefaultCodespace))
+		AddRoute(ethbridge.RouterKey, ethbridge.NewHandler(app.ethBridgeKeeper, app.cdc, ethbridge.DefaultCodespace)).
+		AddRoute(ethheaders.RouterKey, ethheaders.NewHandler(app.ethHeadersKeeper))
 
 	// The app.QueryRouter is the main query router where each module registers its routes
 	app.QueryRouter().
 		AddRoute(auth.QuerierRoute, auth.NewQuerier(app.accountKeeper)).
 		AddRoute(staking.QuerierRoute, staking.NewQuerier(app.stakingKeeper, app.cdc)).
-		AddRoute(ethbridge.QuerierRoute, ethbridge.NewQuerier(app.oracleKeeper, app.cdc, ethbridge.DefaultCodespace))
+		AddRoute(ethbridge.QuerierRoute, ethbridge.NewQuerier(app.ethBridgeKeeper, app.cdc, ethbridge.DefaultCodespace)).
+		AddRoute(ethheaders.QuerierRoute, ethheaders.NewQuerier(app.ethHeadersKeeper, app.cdc))
 
 	// The initChainer handles translating the genesis.json file into initial state for the network
 	app.SetInitChainer(app.initChainer)
@@ -134,6 +174,8 @@ func NewEthereumBridgeApp(logger log.Logger, db dbm.DB) *ethereumBridgeApp {
 		app.keyAccount,
 		app.keyStaking,
 		app.keyOracle,
+		app.keyEthBridge,
+		app.keyEthHeaders,
 		app.keyFeeCollection,
 		app.keyParams,
 		app.tkeyParams,
@@ -169,6 +211,8 @@ func (app *ethereumBridgeApp) initFromGenesisState(ctx sdk.Context, genesisState
 	// initialize module-specific stores
 	auth.InitGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper, genesisState.AuthData)
 	bank.InitGenesis(ctx, app.bankKeeper, genesisState.BankData)
+	ethbridge.InitGenesis(ctx, app.ethBridgeKeeper, genesisState.EthBridgeData)
+	ethheaders.InitGenesis(ctx, app.ethHeadersKeeper, genesisState.EthHeadersData)
 
 	if len(genesisState.GenTxs) > 0 {
 		for _, genTx := range genesisState.GenTxs {
@@ -213,6 +257,7 @@ func MakeCodec() *codec.Codec {
 	bank.RegisterCodec(cdc)
 	staking.RegisterCodec(cdc)
 	ethbridge.RegisterCodec(cdc)
+	ethheaders.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	return cdc
@@ -222,6 +267,11 @@ func MakeCodec() *codec.Codec {
 // nolint: unparam
 func (app *ethereumBridgeApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.ResponseEndBlock {
 	validatorUpdates, _ := staking.EndBlocker(ctx, app.stakingKeeper)
+	ethbridge.EndBlocker(ctx, app.ethBridgeKeeper)
+
+	if ctx.BlockHeight()%invariantCheckPeriod == 0 {
+		app.assertRuntimeInvariants(ctx)
+	}
 
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: validatorUpdates,
@@ -27,11 +27,14 @@ import (
 	app "github.com/pumpkinzomb/cosmos-ethereum-bridge"
 	ethbridgeclient "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/client"
 	ethbridgerest "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/client/rest"
+	ethheadersclient "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/client"
+	ethheadersrest "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/client/rest"
 )
 
 const (
-	storeAcc       = "acc"
-	routeEthbridge = "ethbridge"
+	storeAcc        = "acc"
+	routeEthbridge  = "ethbridge"
+	routeEthheaders = "ethheaders"
 )
 
 var defaultCLIHome = os.ExpandEnv("$HOME/.ebcli")
@@ -50,6 +53,7 @@ func main() {
 
 	mc := []sdk.ModuleClients{
 		ethbridgeclient.NewModuleClient(routeEthbridge, cdc),
+		ethheadersclient.NewModuleClient(routeEthheaders, cdc),
 		stakingclient.NewModuleClient(stakingModule.StoreKey, cdc),
 	}
 
@@ -92,6 +96,7 @@ func registerRoutes(rs *lcd.RestServer) {
 	bank.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	stakingrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, rs.KeyBase)
 	ethbridgerest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, routeEthbridge)
+	ethheadersrest.RegisterRoutes(rs.CliCtx, rs.Mux, rs.Cdc, routeEthheaders)
 }
 
 func queryCmd(cdc *amino.Codec, mc []sdk.ModuleClients) *cobra.Command {
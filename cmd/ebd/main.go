@@ -16,6 +16,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -39,11 +41,13 @@ import (
 var DefaultNodeHome = os.ExpandEnv("$HOME/.ebd")
 
 const (
-	flagOverwrite    = "overwrite"
-	flagClientHome   = "home-client"
-	flagVestingStart = "vesting-start-time"
-	flagVestingEnd   = "vesting-end-time"
-	flagVestingAmt   = "vesting-amount"
+	flagOverwrite      = "overwrite"
+	flagClientHome     = "home-client"
+	flagVestingStart   = "vesting-start-time"
+	flagVestingEnd     = "vesting-end-time"
+	flagVestingAmt     = "vesting-amount"
+	flagBridgeAdmin    = "ethbridge-admin"
+	flagBridgeGuardian = "ethbridge-guardian"
 )
 
 func main() {
@@ -118,10 +122,26 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("genesis.json file already exists: %v", genFile)
 			}
 
+			ethBridgeData := ethbridge.DefaultGenesisState()
+			if admin := viper.GetString(flagBridgeAdmin); admin != "" {
+				ethBridgeData.Params.Admin, err = sdk.AccAddressFromBech32(admin)
+				if err != nil {
+					return err
+				}
+			}
+			if guardian := viper.GetString(flagBridgeGuardian); guardian != "" {
+				ethBridgeData.Params.Guardian, err = sdk.AccAddressFromBech32(guardian)
+				if err != nil {
+					return err
+				}
+			}
+
 			genesis := app.GenesisState{
-				AuthData:    auth.DefaultGenesisState(),
-				BankData:    bank.DefaultGenesisState(),
-				StakingData: staking.DefaultGenesisState(),
+				AuthData:       auth.DefaultGenesisState(),
+				BankData:       bank.DefaultGenesisState(),
+				StakingData:    staking.DefaultGenesisState(),
+				EthBridgeData:  ethBridgeData,
+				EthHeadersData: ethheaders.DefaultGenesisState(),
 			}
 
 			appState, err = codec.MarshalJSONIndent(cdc, genesis)
@@ -148,6 +168,8 @@ func InitCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(cli.HomeFlag, DefaultNodeHome, "node's home directory")
 	cmd.Flags().String(client.FlagChainID, "", "genesis file chain-id, if left blank will be randomly created")
 	cmd.Flags().BoolP(flagOverwrite, "o", false, "overwrite the genesis.json file")
+	cmd.Flags().String(flagBridgeAdmin, "", "address allowed to pause ethbridge minting, set mint limits and release queued mints")
+	cmd.Flags().String(flagBridgeGuardian, "", "address allowed, besides the ethbridge admin, to cancel pending releases of large transfers")
 
 	return cmd
 }
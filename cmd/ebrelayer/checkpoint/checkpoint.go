@@ -0,0 +1,196 @@
+package checkpoint
+
+// -----------------------------------------------------
+//    Checkpoint
+//
+//    Persists the position of the last Ethereum log the
+//    relayer fully processed for each contract, so that a
+//    restarted relayer resumes where it stopped, and the
+//    relayed logs that were later reorged out of the chain.
+// -----------------------------------------------------
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+const (
+	dbName = "ebrelayer"
+	dbDir  = "data"
+)
+
+var (
+	checkpointPrefix = []byte("checkpoint/")
+	reorgPrefix      = []byte("reorg/")
+)
+
+// Checkpoint is the position of a log on the ethereum chain
+type Checkpoint struct {
+	BlockNumber uint64 `json:"block_number"`
+	LogIndex    uint   `json:"log_index"`
+}
+
+// New returns the checkpoint of a log
+func New(blockNumber uint64, logIndex uint) Checkpoint {
+	return Checkpoint{
+		BlockNumber: blockNumber,
+		LogIndex:    logIndex,
+	}
+}
+
+// AfterBlock returns the checkpoint that covers every log of a block
+func AfterBlock(blockNumber uint64) Checkpoint {
+	return New(blockNumber, ^uint(0))
+}
+
+// Covers returns whether the log at a position was processed by the time of the checkpoint
+func (checkpoint Checkpoint) Covers(blockNumber uint64, logIndex uint) bool {
+	if blockNumber != checkpoint.BlockNumber {
+		return blockNumber < checkpoint.BlockNumber
+	}
+	return logIndex <= checkpoint.LogIndex
+}
+
+func (checkpoint Checkpoint) String() string {
+	return fmt.Sprintf("block %d, log %d", checkpoint.BlockNumber, checkpoint.LogIndex)
+}
+
+// Reorg is a relayed log that was removed from the chain by a reorg
+type Reorg struct {
+	BlockNumber uint64      `json:"block_number"`
+	BlockHash   common.Hash `json:"block_hash"`
+	TxHash      common.Hash `json:"tx_hash"`
+	LogIndex    uint        `json:"log_index"`
+	DetectedAt  time.Time   `json:"detected_at"`
+}
+
+// NewReorg returns the record of a reorged log
+func NewReorg(blockNumber uint64, blockHash common.Hash, txHash common.Hash, logIndex uint, detectedAt time.Time) Reorg {
+	return Reorg{
+		BlockNumber: blockNumber,
+		BlockHash:   blockHash,
+		TxHash:      txHash,
+		LogIndex:    logIndex,
+		DetectedAt:  detectedAt,
+	}
+}
+
+func (reorg Reorg) String() string {
+	return fmt.Sprintf("block %d (%s), log %d of tx %s, detected at %s", reorg.BlockNumber, reorg.BlockHash.Hex(),
+		reorg.LogIndex, reorg.TxHash.Hex(), reorg.DetectedAt.Format(time.RFC3339))
+}
+
+// Store keeps the checkpoint of each contract in an embedded database
+type Store struct {
+	db dbm.DB
+	// network namespaces the keys of the contracts of an ethereum network
+	network string
+}
+
+// NewStore returns a store backed by a database
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// ForNetwork returns the store of the contracts of an ethereum network, which shares the database. The store of
+// the unnamed network keeps the checkpoints of a relayer of a single contract.
+func (store *Store) ForNetwork(network string) *Store {
+	return &Store{db: store.db, network: network}
+}
+
+// OpenStore opens the leveldb store under the data directory of the relayer home
+func OpenStore(home string) (*Store, error) {
+	db, err := dbm.NewGoLevelDB(dbName, filepath.Join(home, dbDir))
+	if err != nil {
+		return nil, err
+	}
+	return NewStore(db), nil
+}
+
+// Get returns the checkpoint of a contract, if the relayer processed any of its logs
+func (store *Store) Get(contract common.Address) (Checkpoint, bool) {
+	bz := store.db.Get(store.checkpointKey(contract))
+	if bz == nil {
+		return Checkpoint{}, false
+	}
+	var checkpoint Checkpoint
+	if err := json.Unmarshal(bz, &checkpoint); err != nil {
+		return Checkpoint{}, false
+	}
+	return checkpoint, true
+}
+
+// Set durably records the checkpoint of a contract
+func (store *Store) Set(contract common.Address, checkpoint Checkpoint) error {
+	bz, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	store.db.SetSync(store.checkpointKey(contract), bz)
+	return nil
+}
+
+// RecordReorg durably records a reorged log of a contract
+func (store *Store) RecordReorg(contract common.Address, reorg Reorg) error {
+	bz, err := json.Marshal(reorg)
+	if err != nil {
+		return err
+	}
+	store.db.SetSync(store.reorgKey(contract, reorg), bz)
+	return nil
+}
+
+// Reorgs returns the reorged logs recorded for a contract, in chain order
+func (store *Store) Reorgs(contract common.Address) ([]Reorg, error) {
+	prefix := store.contractKey(reorgPrefix, contract)
+	iterator := dbm.IteratePrefix(store.db, prefix)
+	defer iterator.Close()
+
+	reorgs := []Reorg{}
+	for ; iterator.Valid(); iterator.Next() {
+		var reorg Reorg
+		if err := json.Unmarshal(iterator.Value(), &reorg); err != nil {
+			return nil, err
+		}
+		reorgs = append(reorgs, reorg)
+	}
+	return reorgs, nil
+}
+
+// DB returns the database of the store, which also keeps the other persistent state of the relayer
+func (store *Store) DB() dbm.DB {
+	return store.db
+}
+
+// Close closes the database
+func (store *Store) Close() {
+	store.db.Close()
+}
+
+// contractKey returns the key of a contract under a prefix, namespaced by the network of the store
+func (store *Store) contractKey(prefix []byte, contract common.Address) []byte {
+	key := append([]byte{}, prefix...)
+	if store.network != "" {
+		key = append(key, []byte(store.network+"/")...)
+	}
+	return append(key, contract.Bytes()...)
+}
+
+func (store *Store) checkpointKey(contract common.Address) []byte {
+	return store.contractKey(checkpointPrefix, contract)
+}
+
+func (store *Store) reorgKey(contract common.Address, reorg Reorg) []byte {
+	key := store.contractKey(reorgPrefix, contract)
+	position := make([]byte, 16)
+	binary.BigEndian.PutUint64(position, reorg.BlockNumber)
+	binary.BigEndian.PutUint64(position[8:], uint64(reorg.LogIndex))
+	key = append(key, position...)
+	return append(key, reorg.BlockHash.Bytes()...)
+}
@@ -0,0 +1,70 @@
+package checkpoint
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+func TestStore(t *testing.T) {
+	store := NewStore(dbm.NewMemDB())
+	peggy := common.HexToAddress("0x7B95B6EC7EbD73572298cEf32Bb54FA408207359")
+	other := common.HexToAddress("0x7B95B6EC7EbD73572298cEf32Bb54FA408207344")
+
+	_, found := store.Get(peggy)
+	require.False(t, found)
+
+	require.NoError(t, store.Set(peggy, New(10, 2)))
+	checkpoint, found := store.Get(peggy)
+	require.True(t, found)
+	require.Equal(t, New(10, 2), checkpoint)
+
+	// Each contract has its own checkpoint
+	_, found = store.Get(other)
+	require.False(t, found)
+
+	// and so has the same contract on each network
+	_, found = store.ForNetwork("ropsten").Get(peggy)
+	require.False(t, found)
+	require.NoError(t, store.ForNetwork("ropsten").Set(peggy, New(20, 0)))
+	checkpoint, _ = store.ForNetwork("ropsten").Get(peggy)
+	require.Equal(t, New(20, 0), checkpoint)
+	checkpoint, _ = store.Get(peggy)
+	require.Equal(t, New(10, 2), checkpoint)
+}
+
+func TestCovers(t *testing.T) {
+	checkpoint := New(10, 2)
+	require.True(t, checkpoint.Covers(9, 7))
+	require.True(t, checkpoint.Covers(10, 2))
+	require.False(t, checkpoint.Covers(10, 3))
+	require.False(t, checkpoint.Covers(11, 0))
+
+	// A checkpoint after a block covers all of its logs
+	require.True(t, AfterBlock(10).Covers(10, 500))
+	require.False(t, AfterBlock(10).Covers(11, 0))
+}
+
+func TestReorgs(t *testing.T) {
+	store := NewStore(dbm.NewMemDB())
+	peggy := common.HexToAddress("0x7B95B6EC7EbD73572298cEf32Bb54FA408207359")
+	other := common.HexToAddress("0x7B95B6EC7EbD73572298cEf32Bb54FA408207344")
+	detectedAt := time.Unix(1560000000, 0).UTC()
+
+	later := NewReorg(12, common.HexToHash("0x12"), common.HexToHash("0xa"), 0, detectedAt)
+	earlier := NewReorg(10, common.HexToHash("0x10"), common.HexToHash("0xb"), 3, detectedAt)
+	require.NoError(t, store.RecordReorg(peggy, later))
+	require.NoError(t, store.RecordReorg(peggy, earlier))
+	require.NoError(t, store.Set(peggy, New(12, 0)))
+
+	reorgs, err := store.Reorgs(peggy)
+	require.NoError(t, err)
+	require.Equal(t, []Reorg{earlier, later}, reorgs)
+
+	reorgs, err = store.Reorgs(other)
+	require.NoError(t, err)
+	require.Empty(t, reorgs)
+}
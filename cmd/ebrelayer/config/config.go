@@ -0,0 +1,220 @@
+package config
+
+// -----------------------------------------------------
+//    Config
+//
+//    Loads the relayer configuration, which declares the
+//    Cosmos chain claims are relayed to and the Peggy
+//    contracts watched on each Ethereum network.
+// -----------------------------------------------------
+
+import (
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
+
+	relayer "github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/relayer"
+)
+
+const (
+	// FileName is the name of the relayer configuration under the config directory of the home, as
+	// relayer.toml or relayer.yaml
+	FileName = "relayer"
+
+	// DefaultEvents are the events relayed for a contract that does not select any
+	DefaultEvents = "LogLock,LogWithdraw,LogUnlock"
+)
+
+// Config is the configuration of a relayer serving several peggy contracts
+type Config struct {
+	ChainID string `mapstructure:"chain_id"`
+	// Node is the RPC address of the cosmos node claims are broadcast to
+	Node string `mapstructure:"node"`
+	// KeyName is the key signing the claims
+	KeyName string `mapstructure:"key_name"`
+	// Validator is the address of the validator to claim for when the key is its feeder
+	Validator     string  `mapstructure:"validator"`
+	Gas           string  `mapstructure:"gas"`
+	GasAdjustment float64 `mapstructure:"gas_adjustment"`
+	GasPrices     string  `mapstructure:"gas_prices"`
+	Fees          string  `mapstructure:"fees"`
+	// Confirmations is the confirmation depth of the networks that do not set their own
+	Confirmations     uint64    `mapstructure:"confirmations"`
+	MetricsListenAddr string    `mapstructure:"metrics_listen_addr"`
+	Networks          []Network `mapstructure:"networks"`
+}
+
+// Network is an ethereum network and the peggy contracts relayed from it
+type Network struct {
+	Name          string        `mapstructure:"name"`
+	Provider      string        `mapstructure:"provider"`
+	PollInterval  time.Duration `mapstructure:"poll_interval"`
+	Confirmations *uint64       `mapstructure:"confirmations"`
+	Contracts     []Contract    `mapstructure:"contracts"`
+}
+
+// Contract is a peggy contract and the events of it that are relayed
+type Contract struct {
+	Address string `mapstructure:"address"`
+	Events  string `mapstructure:"events"`
+}
+
+// Load reads the relayer configuration from the config directory of the home
+func Load(home string) (Config, error) {
+	v := viper.New()
+	v.SetConfigName(FileName)
+	v.AddConfigPath(filepath.Join(home, "config"))
+	return read(v)
+}
+
+// LoadFile reads the relayer configuration from a file, whose extension gives its format
+func LoadFile(path string) (Config, error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	return read(v)
+}
+
+func read(v *viper.Viper) (Config, error) {
+	v.SetDefault("gas_adjustment", client.DefaultGasAdjustment)
+	v.SetDefault("confirmations", relayer.DefaultConfirmations)
+	if err := v.ReadInConfig(); err != nil {
+		return Config{}, err
+	}
+
+	var config Config
+	if err := v.Unmarshal(&config); err != nil {
+		return Config{}, err
+	}
+	if err := config.ValidateBasic(); err != nil {
+		return Config{}, fmt.Errorf("invalid relayer config %s: %s", v.ConfigFileUsed(), err)
+	}
+	return config, nil
+}
+
+// ValidateBasic checks that the configuration declares a chain, a key and at least one contract
+func (config Config) ValidateBasic() error {
+	if config.ChainID == "" {
+		return fmt.Errorf("chain_id is required")
+	}
+	if config.KeyName == "" {
+		return fmt.Errorf("key_name is required")
+	}
+	if _, err := config.ValidatorAddress(); err != nil {
+		return err
+	}
+	if _, _, err := client.ParseGas(config.Gas); err != nil {
+		return err
+	}
+	if len(config.Networks) == 0 {
+		return fmt.Errorf("no network is declared")
+	}
+
+	names := make(map[string]bool)
+	for _, network := range config.Networks {
+		if network.Name == "" {
+			return fmt.Errorf("a network has no name")
+		}
+		if names[network.Name] {
+			return fmt.Errorf("network %s is declared twice", network.Name)
+		}
+		names[network.Name] = true
+		if err := network.ValidateBasic(); err != nil {
+			return fmt.Errorf("network %s: %s", network.Name, err)
+		}
+	}
+	return nil
+}
+
+// ValidatorAddress parses the address of the validator the key feeds claims for, which is empty for the key's
+// own validator
+func (config Config) ValidatorAddress() (sdk.AccAddress, error) {
+	if config.Validator == "" {
+		return nil, nil
+	}
+	validator, err := sdk.AccAddressFromBech32(config.Validator)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator: %v", config.Validator)
+	}
+	return validator, nil
+}
+
+// ValidateBasic checks the provider and the contracts of a network
+func (network Network) ValidateBasic() error {
+	if !relayer.IsWebsocketURL(network.Provider) && !relayer.IsHTTPURL(network.Provider) {
+		return fmt.Errorf("invalid provider: %v", network.Provider)
+	}
+	if network.PollInterval < 0 {
+		return fmt.Errorf("invalid poll_interval: %v", network.PollInterval)
+	}
+	if len(network.Contracts) == 0 {
+		return fmt.Errorf("no contract is declared")
+	}
+
+	addresses := make(map[common.Address]bool)
+	for _, contract := range network.Contracts {
+		address, err := contract.ContractAddress()
+		if err != nil {
+			return err
+		}
+		if addresses[address] {
+			return fmt.Errorf("contract %s is declared twice", address.Hex())
+		}
+		addresses[address] = true
+	}
+	return nil
+}
+
+// ContractAddress parses the hex address of the contract
+func (contract Contract) ContractAddress() (common.Address, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(contract.Address, "0x"))
+	if err != nil || len(bz) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("invalid contract address: %v", contract.Address)
+	}
+	return common.BytesToAddress(bz), nil
+}
+
+// Targets returns the contracts of every network that the relayer serves
+func (config Config) Targets() []relayer.Target {
+	var targets []relayer.Target
+	for _, network := range config.Networks {
+		confirmations := config.Confirmations
+		if network.Confirmations != nil {
+			confirmations = *network.Confirmations
+		}
+		for _, contract := range network.Contracts {
+			address, _ := contract.ContractAddress()
+			events := contract.Events
+			if events == "" {
+				events = DefaultEvents
+			}
+			targets = append(targets, relayer.Target{
+				Network:       network.Name,
+				Provider:      network.Provider,
+				Contract:      address,
+				Events:        events,
+				PollInterval:  network.PollInterval,
+				Confirmations: confirmations,
+			})
+		}
+	}
+	return targets
+}
+
+// Apply sets the cosmos node and the gas settings of the claims' transactions
+func (config Config) Apply() error {
+	if config.Node != "" {
+		viper.Set(client.FlagNode, config.Node)
+	}
+	viper.Set(client.FlagChainID, config.ChainID)
+	viper.Set(client.FlagGasAdjustment, config.GasAdjustment)
+	viper.Set(client.FlagGasPrices, config.GasPrices)
+	viper.Set(client.FlagFees, config.Fees)
+	return client.GasFlagVar.Set(config.Gas)
+}
@@ -0,0 +1,137 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const testTOML = `
+chain_id = "testing"
+node = "tcp://localhost:26657"
+key_name = "validator"
+gas = "auto"
+gas_adjustment = 1.5
+fees = "5stake"
+
+[[networks]]
+name = "ropsten"
+provider = "wss://ropsten.infura.io/ws"
+
+[[networks.contracts]]
+address = "3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb"
+
+[[networks.contracts]]
+address = "0x7B95B6EC7EbD73572298cEf32Bb54FA408207359"
+events = "LogLock"
+
+[[networks]]
+name = "local"
+provider = "http://localhost:8545"
+poll_interval = "15s"
+confirmations = 0
+
+[[networks.contracts]]
+address = "3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb"
+`
+
+const testYAML = `
+chain_id: testing
+key_name: validator
+networks:
+  - name: local
+    provider: http://localhost:8545
+    contracts:
+      - address: 3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb
+`
+
+func writeConfig(t *testing.T, home string, name string, content string) string {
+	dir := filepath.Join(home, "config")
+	require.NoError(t, os.MkdirAll(dir, 0755))
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0644))
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	home, err := ioutil.TempDir("", "ebrelayer")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	writeConfig(t, home, "relayer.toml", testTOML)
+
+	config, err := Load(home)
+	require.NoError(t, err)
+	require.Equal(t, "testing", config.ChainID)
+	require.Equal(t, "auto", config.Gas)
+	require.Equal(t, 1.5, config.GasAdjustment)
+
+	peggy := common.HexToAddress("3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb")
+	targets := config.Targets()
+	require.Len(t, targets, 3)
+	require.Equal(t, "ropsten", targets[0].Network)
+	require.Equal(t, peggy, targets[0].Contract)
+	require.Equal(t, DefaultEvents, targets[0].Events)
+	require.Equal(t, uint64(6), targets[0].Confirmations)
+	require.Equal(t, "LogLock", targets[1].Events)
+
+	// A network can set its own confirmation depth
+	require.Equal(t, "local", targets[2].Network)
+	require.Equal(t, 15*time.Second, targets[2].PollInterval)
+	require.Equal(t, uint64(0), targets[2].Confirmations)
+}
+
+func TestLoadYAML(t *testing.T) {
+	home, err := ioutil.TempDir("", "ebrelayer")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	path := writeConfig(t, home, "relayer.yaml", testYAML)
+
+	config, err := LoadFile(path)
+	require.NoError(t, err)
+	require.Len(t, config.Targets(), 1)
+	require.Equal(t, "local", config.Targets()[0].Network)
+}
+
+func TestValidateBasic(t *testing.T) {
+	valid := func() Config {
+		return Config{
+			ChainID: "testing",
+			KeyName: "validator",
+			Networks: []Network{{
+				Name:      "local",
+				Provider:  "http://localhost:8545",
+				Contracts: []Contract{{Address: "3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb"}},
+			}},
+		}
+	}
+	require.NoError(t, valid().ValidateBasic())
+
+	config := valid()
+	config.KeyName = ""
+	require.Error(t, config.ValidateBasic())
+
+	config = valid()
+	config.Gas = "lots"
+	require.Error(t, config.ValidateBasic())
+
+	config = valid()
+	config.Networks = append(config.Networks, config.Networks[0])
+	require.Error(t, config.ValidateBasic())
+
+	config = valid()
+	config.Networks[0].Provider = "ipc:///tmp/geth.ipc"
+	require.Error(t, config.ValidateBasic())
+
+	config = valid()
+	config.Networks[0].Contracts = append(config.Networks[0].Contracts, Contract{Address: "0x1234"})
+	require.Error(t, config.ValidateBasic())
+
+	config = valid()
+	config.Networks[0].Contracts = append(config.Networks[0].Contracts, config.Networks[0].Contracts[0])
+	require.Error(t, config.ValidateBasic())
+}
@@ -3,29 +3,50 @@ package contract
 // -------------------------------------------------------
 //    Contract
 //
-//		Contains functionality related to the smart contract
+//		Contains functionality related to the smart contract.
+//		The Go bindings of Peggy and Processor are generated
+//		from their ABIs and embed them in the binary.
 // -------------------------------------------------------
 
+//go:generate abigen --abi PeggyABI.json --pkg contract --type Peggy --out peggy.go
+//go:generate abigen --abi ProcessorABI.json --pkg contract --type Processor --out processor.go
+
 import (
-	"io/ioutil"
-	"log"
+	"encoding/hex"
+	"fmt"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 )
 
+// LoadABI returns Peggy contract's ABI, embedded in its bindings
 func LoadABI() abi.ABI {
-	// Open the file containing Peggy contract's ABI
-	rawContractAbi, errorMsg := ioutil.ReadFile("cmd/ebrelayer/contract/PeggyABI.json")
-	if errorMsg != nil {
-		log.Fatal(errorMsg)
+	contractAbi, err := PeggyMetaData.GetAbi()
+	if err != nil {
+		// The embedded ABI is generated, so it always parses
+		panic(err)
 	}
+	return *contractAbi
+}
 
-	// Convert the raw abi into a usable format
-	contractAbi, err := abi.JSON(strings.NewReader(string(rawContractAbi)))
-	if err != nil {
-		log.Fatal(err)
+// ParseItemID decodes the hex id of a peggy item
+func ParseItemID(itemID string) ([32]byte, error) {
+	var id [32]byte
+	bz, err := hex.DecodeString(strings.TrimPrefix(itemID, "0x"))
+	if err != nil || len(bz) != common.HashLength {
+		return id, fmt.Errorf("invalid item id: %v", itemID)
 	}
+	copy(id[:], bz)
+	return id, nil
+}
 
-	return contractAbi
+// PackUnlock encodes a call to peggy's unlock for the given item id, which returns
+// the item's locked funds to its original sender
+func PackUnlock(contractAbi abi.ABI, itemID string) ([]byte, error) {
+	id, err := ParseItemID(itemID)
+	if err != nil {
+		return nil, err
+	}
+	return contractAbi.Pack("unlock", id)
 }
@@ -1,22 +1,58 @@
 package contract
 
 import (
-  "testing"
-  "io/ioutil"
-  "strings"
-  "log"
+	"strings"
+	"testing"
 
-  "github.com/stretchr/testify/require"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
 )
 
 // Set up data for parameters and to compare against
 func TestLoadABI(t *testing.T) {
+	contractAbi := LoadABI()
 
-	//Get the ABI ready
-	rawContractAbi, errorMsg := ioutil.ReadFile("./PeggyABI.json")
-	if errorMsg != nil {
-		log.Fatal(errorMsg)
-	}
+	require.Contains(t, contractAbi.Events, "LogLock")
+	require.Equal(t, "0xe154a56f2d306d5bbe4ac2379cb0cfc906b23685047a2bd2f5f0a0e810888f72",
+		contractAbi.Events["LogLock"].ID.Hex())
+}
 
-	require.True(t, strings.Contains(string(rawContractAbi), "LogLock"))
-}
\ No newline at end of file
+func TestPackUnlock(t *testing.T) {
+	contractAbi := LoadABI()
+
+	itemID := "0x" + strings.Repeat("ab", 32)
+	data, err := PackUnlock(contractAbi, itemID)
+	require.NoError(t, err)
+	require.Equal(t, contractAbi.Methods["unlock"].ID, data[:4])
+	require.Len(t, data, 36)
+
+	_, err = PackUnlock(contractAbi, "0x1234")
+	require.Error(t, err)
+}
+
+func TestParseLogLock(t *testing.T) {
+	contractAbi := LoadABI()
+	peggy, err := NewPeggyFilterer(common.Address{}, nil)
+	require.NoError(t, err)
+
+	id, err := ParseItemID("0x" + strings.Repeat("ab", 32))
+	require.NoError(t, err)
+	from := common.HexToAddress("0x7B95B6EC7EbD73572298cEf32Bb54FA408207359")
+	data, err := contractAbi.Events["LogLock"].Inputs.Pack(id, from, []byte("cosmos1recipient"),
+		common.Address{}, common.Big1, common.Big2)
+	require.NoError(t, err)
+
+	vLog := types.Log{Topics: []common.Hash{contractAbi.Events["LogLock"].ID}, Data: data}
+	event, err := peggy.ParseLogLock(vLog)
+	require.NoError(t, err)
+	require.Equal(t, id, event.Id)
+	require.Equal(t, from, event.From)
+	require.Equal(t, []byte("cosmos1recipient"), event.To)
+	require.Equal(t, common.Big2, event.Nonce)
+
+	// Logs of other events are rejected
+	vLog.Topics = []common.Hash{contractAbi.Events["LogUnlock"].ID}
+	_, err = peggy.ParseLogLock(vLog)
+	require.Error(t, err)
+}
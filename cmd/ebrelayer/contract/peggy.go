@@ -0,0 +1,1158 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contract
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// PeggyMetaData contains all meta data concerning the Peggy contract.
+var PeggyMetaData = &bind.MetaData{
+	ABI: "[{\"constant\":false,\"inputs\":[],\"name\":\"activateLocking\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_recipient\",\"type\":\"bytes\"},{\"name\":\"_token\",\"type\":\"address\"},{\"name\":\"_amount\",\"type\":\"uint256\"}],\"name\":\"lock\",\"outputs\":[{\"name\":\"_id\",\"type\":\"bytes32\"}],\"payable\":true,\"stateMutability\":\"payable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[],\"name\":\"pauseLocking\",\"outputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_id\",\"type\":\"bytes32\"}],\"name\":\"unlock\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"constant\":false,\"inputs\":[{\"name\":\"_id\",\"type\":\"bytes32\"}],\"name\":\"withdraw\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_id\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"_from\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_to\",\"type\":\"bytes\"},{\"indexed\":false,\"name\":\"_token\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_value\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"_nonce\",\"type\":\"uint256\"}],\"name\":\"LogLock\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_id\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"_to\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_token\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_value\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"_nonce\",\"type\":\"uint256\"}],\"name\":\"LogUnlock\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_id\",\"type\":\"bytes32\"},{\"indexed\":false,\"name\":\"_to\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_token\",\"type\":\"address\"},{\"indexed\":false,\"name\":\"_value\",\"type\":\"uint256\"},{\"indexed\":false,\"name\":\"_nonce\",\"type\":\"uint256\"}],\"name\":\"LogWithdraw\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_time\",\"type\":\"uint256\"}],\"name\":\"LogLockingPaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"name\":\"_time\",\"type\":\"uint256\"}],\"name\":\"LogLockingActivated\",\"type\":\"event\"},{\"constant\":true,\"inputs\":[],\"name\":\"active\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_id\",\"type\":\"bytes32\"}],\"name\":\"getStatus\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"ids\",\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"nonce\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[],\"name\":\"relayer\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"constant\":true,\"inputs\":[{\"name\":\"_id\",\"type\":\"bytes32\"}],\"name\":\"viewItem\",\"outputs\":[{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"bytes\"},{\"name\":\"\",\"type\":\"address\"},{\"name\":\"\",\"type\":\"uint256\"},{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"}]",
+}
+
+// PeggyABI is the input ABI used to generate the binding from.
+// Deprecated: Use PeggyMetaData.ABI instead.
+var PeggyABI = PeggyMetaData.ABI
+
+// Peggy is an auto generated Go binding around an Ethereum contract.
+type Peggy struct {
+	PeggyCaller     // Read-only binding to the contract
+	PeggyTransactor // Write-only binding to the contract
+	PeggyFilterer   // Log filterer for contract events
+}
+
+// PeggyCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PeggyCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PeggyTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PeggyTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PeggyFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PeggyFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PeggySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PeggySession struct {
+	Contract     *Peggy            // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// PeggyCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PeggyCallerSession struct {
+	Contract *PeggyCaller  // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// PeggyTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PeggyTransactorSession struct {
+	Contract     *PeggyTransactor  // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// PeggyRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PeggyRaw struct {
+	Contract *Peggy // Generic contract binding to access the raw methods on
+}
+
+// PeggyCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PeggyCallerRaw struct {
+	Contract *PeggyCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PeggyTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PeggyTransactorRaw struct {
+	Contract *PeggyTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPeggy creates a new instance of Peggy, bound to a specific deployed contract.
+func NewPeggy(address common.Address, backend bind.ContractBackend) (*Peggy, error) {
+	contract, err := bindPeggy(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Peggy{PeggyCaller: PeggyCaller{contract: contract}, PeggyTransactor: PeggyTransactor{contract: contract}, PeggyFilterer: PeggyFilterer{contract: contract}}, nil
+}
+
+// NewPeggyCaller creates a new read-only instance of Peggy, bound to a specific deployed contract.
+func NewPeggyCaller(address common.Address, caller bind.ContractCaller) (*PeggyCaller, error) {
+	contract, err := bindPeggy(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PeggyCaller{contract: contract}, nil
+}
+
+// NewPeggyTransactor creates a new write-only instance of Peggy, bound to a specific deployed contract.
+func NewPeggyTransactor(address common.Address, transactor bind.ContractTransactor) (*PeggyTransactor, error) {
+	contract, err := bindPeggy(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PeggyTransactor{contract: contract}, nil
+}
+
+// NewPeggyFilterer creates a new log filterer instance of Peggy, bound to a specific deployed contract.
+func NewPeggyFilterer(address common.Address, filterer bind.ContractFilterer) (*PeggyFilterer, error) {
+	contract, err := bindPeggy(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PeggyFilterer{contract: contract}, nil
+}
+
+// bindPeggy binds a generic wrapper to an already deployed contract.
+func bindPeggy(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(PeggyABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Peggy *PeggyRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Peggy.Contract.PeggyCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Peggy *PeggyRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Peggy.Contract.PeggyTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Peggy *PeggyRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Peggy.Contract.PeggyTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Peggy *PeggyCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Peggy.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Peggy *PeggyTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Peggy.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Peggy *PeggyTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Peggy.Contract.contract.Transact(opts, method, params...)
+}
+
+// Active is a free data retrieval call binding the contract method 0x02fb0c5e.
+//
+// Solidity: function active() view returns(bool)
+func (_Peggy *PeggyCaller) Active(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _Peggy.contract.Call(opts, &out, "active")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Active is a free data retrieval call binding the contract method 0x02fb0c5e.
+//
+// Solidity: function active() view returns(bool)
+func (_Peggy *PeggySession) Active() (bool, error) {
+	return _Peggy.Contract.Active(&_Peggy.CallOpts)
+}
+
+// Active is a free data retrieval call binding the contract method 0x02fb0c5e.
+//
+// Solidity: function active() view returns(bool)
+func (_Peggy *PeggyCallerSession) Active() (bool, error) {
+	return _Peggy.Contract.Active(&_Peggy.CallOpts)
+}
+
+// GetStatus is a free data retrieval call binding the contract method 0x5de28ae0.
+//
+// Solidity: function getStatus(bytes32 _id) view returns(bool)
+func (_Peggy *PeggyCaller) GetStatus(opts *bind.CallOpts, _id [32]byte) (bool, error) {
+	var out []interface{}
+	err := _Peggy.contract.Call(opts, &out, "getStatus", _id)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// GetStatus is a free data retrieval call binding the contract method 0x5de28ae0.
+//
+// Solidity: function getStatus(bytes32 _id) view returns(bool)
+func (_Peggy *PeggySession) GetStatus(_id [32]byte) (bool, error) {
+	return _Peggy.Contract.GetStatus(&_Peggy.CallOpts, _id)
+}
+
+// GetStatus is a free data retrieval call binding the contract method 0x5de28ae0.
+//
+// Solidity: function getStatus(bytes32 _id) view returns(bool)
+func (_Peggy *PeggyCallerSession) GetStatus(_id [32]byte) (bool, error) {
+	return _Peggy.Contract.GetStatus(&_Peggy.CallOpts, _id)
+}
+
+// Ids is a free data retrieval call binding the contract method 0xcf7b4a09.
+//
+// Solidity: function ids(bytes32 ) view returns(bool)
+func (_Peggy *PeggyCaller) Ids(opts *bind.CallOpts, arg0 [32]byte) (bool, error) {
+	var out []interface{}
+	err := _Peggy.contract.Call(opts, &out, "ids", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// Ids is a free data retrieval call binding the contract method 0xcf7b4a09.
+//
+// Solidity: function ids(bytes32 ) view returns(bool)
+func (_Peggy *PeggySession) Ids(arg0 [32]byte) (bool, error) {
+	return _Peggy.Contract.Ids(&_Peggy.CallOpts, arg0)
+}
+
+// Ids is a free data retrieval call binding the contract method 0xcf7b4a09.
+//
+// Solidity: function ids(bytes32 ) view returns(bool)
+func (_Peggy *PeggyCallerSession) Ids(arg0 [32]byte) (bool, error) {
+	return _Peggy.Contract.Ids(&_Peggy.CallOpts, arg0)
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_Peggy *PeggyCaller) Nonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Peggy.contract.Call(opts, &out, "nonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_Peggy *PeggySession) Nonce() (*big.Int, error) {
+	return _Peggy.Contract.Nonce(&_Peggy.CallOpts)
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_Peggy *PeggyCallerSession) Nonce() (*big.Int, error) {
+	return _Peggy.Contract.Nonce(&_Peggy.CallOpts)
+}
+
+// Relayer is a free data retrieval call binding the contract method 0x8406c079.
+//
+// Solidity: function relayer() view returns(address)
+func (_Peggy *PeggyCaller) Relayer(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Peggy.contract.Call(opts, &out, "relayer")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Relayer is a free data retrieval call binding the contract method 0x8406c079.
+//
+// Solidity: function relayer() view returns(address)
+func (_Peggy *PeggySession) Relayer() (common.Address, error) {
+	return _Peggy.Contract.Relayer(&_Peggy.CallOpts)
+}
+
+// Relayer is a free data retrieval call binding the contract method 0x8406c079.
+//
+// Solidity: function relayer() view returns(address)
+func (_Peggy *PeggyCallerSession) Relayer() (common.Address, error) {
+	return _Peggy.Contract.Relayer(&_Peggy.CallOpts)
+}
+
+// ViewItem is a free data retrieval call binding the contract method 0xc933dc5b.
+//
+// Solidity: function viewItem(bytes32 _id) view returns(address, bytes, address, uint256, uint256)
+func (_Peggy *PeggyCaller) ViewItem(opts *bind.CallOpts, _id [32]byte) (common.Address, []byte, common.Address, *big.Int, *big.Int, error) {
+	var out []interface{}
+	err := _Peggy.contract.Call(opts, &out, "viewItem", _id)
+
+	if err != nil {
+		return *new(common.Address), *new([]byte), *new(common.Address), *new(*big.Int), *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	out1 := *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+	out2 := *abi.ConvertType(out[2], new(common.Address)).(*common.Address)
+	out3 := *abi.ConvertType(out[3], new(*big.Int)).(**big.Int)
+	out4 := *abi.ConvertType(out[4], new(*big.Int)).(**big.Int)
+
+	return out0, out1, out2, out3, out4, err
+
+}
+
+// ViewItem is a free data retrieval call binding the contract method 0xc933dc5b.
+//
+// Solidity: function viewItem(bytes32 _id) view returns(address, bytes, address, uint256, uint256)
+func (_Peggy *PeggySession) ViewItem(_id [32]byte) (common.Address, []byte, common.Address, *big.Int, *big.Int, error) {
+	return _Peggy.Contract.ViewItem(&_Peggy.CallOpts, _id)
+}
+
+// ViewItem is a free data retrieval call binding the contract method 0xc933dc5b.
+//
+// Solidity: function viewItem(bytes32 _id) view returns(address, bytes, address, uint256, uint256)
+func (_Peggy *PeggyCallerSession) ViewItem(_id [32]byte) (common.Address, []byte, common.Address, *big.Int, *big.Int, error) {
+	return _Peggy.Contract.ViewItem(&_Peggy.CallOpts, _id)
+}
+
+// ActivateLocking is a paid mutator transaction binding the contract method 0x63faf36a.
+//
+// Solidity: function activateLocking() returns()
+func (_Peggy *PeggyTransactor) ActivateLocking(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Peggy.contract.Transact(opts, "activateLocking")
+}
+
+// ActivateLocking is a paid mutator transaction binding the contract method 0x63faf36a.
+//
+// Solidity: function activateLocking() returns()
+func (_Peggy *PeggySession) ActivateLocking() (*types.Transaction, error) {
+	return _Peggy.Contract.ActivateLocking(&_Peggy.TransactOpts)
+}
+
+// ActivateLocking is a paid mutator transaction binding the contract method 0x63faf36a.
+//
+// Solidity: function activateLocking() returns()
+func (_Peggy *PeggyTransactorSession) ActivateLocking() (*types.Transaction, error) {
+	return _Peggy.Contract.ActivateLocking(&_Peggy.TransactOpts)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0x9df2a385.
+//
+// Solidity: function lock(bytes _recipient, address _token, uint256 _amount) payable returns(bytes32 _id)
+func (_Peggy *PeggyTransactor) Lock(opts *bind.TransactOpts, _recipient []byte, _token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Peggy.contract.Transact(opts, "lock", _recipient, _token, _amount)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0x9df2a385.
+//
+// Solidity: function lock(bytes _recipient, address _token, uint256 _amount) payable returns(bytes32 _id)
+func (_Peggy *PeggySession) Lock(_recipient []byte, _token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Peggy.Contract.Lock(&_Peggy.TransactOpts, _recipient, _token, _amount)
+}
+
+// Lock is a paid mutator transaction binding the contract method 0x9df2a385.
+//
+// Solidity: function lock(bytes _recipient, address _token, uint256 _amount) payable returns(bytes32 _id)
+func (_Peggy *PeggyTransactorSession) Lock(_recipient []byte, _token common.Address, _amount *big.Int) (*types.Transaction, error) {
+	return _Peggy.Contract.Lock(&_Peggy.TransactOpts, _recipient, _token, _amount)
+}
+
+// PauseLocking is a paid mutator transaction binding the contract method 0x8a5cd91e.
+//
+// Solidity: function pauseLocking() returns()
+func (_Peggy *PeggyTransactor) PauseLocking(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Peggy.contract.Transact(opts, "pauseLocking")
+}
+
+// PauseLocking is a paid mutator transaction binding the contract method 0x8a5cd91e.
+//
+// Solidity: function pauseLocking() returns()
+func (_Peggy *PeggySession) PauseLocking() (*types.Transaction, error) {
+	return _Peggy.Contract.PauseLocking(&_Peggy.TransactOpts)
+}
+
+// PauseLocking is a paid mutator transaction binding the contract method 0x8a5cd91e.
+//
+// Solidity: function pauseLocking() returns()
+func (_Peggy *PeggyTransactorSession) PauseLocking() (*types.Transaction, error) {
+	return _Peggy.Contract.PauseLocking(&_Peggy.TransactOpts)
+}
+
+// Unlock is a paid mutator transaction binding the contract method 0xec9b5b3a.
+//
+// Solidity: function unlock(bytes32 _id) returns(bool)
+func (_Peggy *PeggyTransactor) Unlock(opts *bind.TransactOpts, _id [32]byte) (*types.Transaction, error) {
+	return _Peggy.contract.Transact(opts, "unlock", _id)
+}
+
+// Unlock is a paid mutator transaction binding the contract method 0xec9b5b3a.
+//
+// Solidity: function unlock(bytes32 _id) returns(bool)
+func (_Peggy *PeggySession) Unlock(_id [32]byte) (*types.Transaction, error) {
+	return _Peggy.Contract.Unlock(&_Peggy.TransactOpts, _id)
+}
+
+// Unlock is a paid mutator transaction binding the contract method 0xec9b5b3a.
+//
+// Solidity: function unlock(bytes32 _id) returns(bool)
+func (_Peggy *PeggyTransactorSession) Unlock(_id [32]byte) (*types.Transaction, error) {
+	return _Peggy.Contract.Unlock(&_Peggy.TransactOpts, _id)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x8e19899e.
+//
+// Solidity: function withdraw(bytes32 _id) returns(bool)
+func (_Peggy *PeggyTransactor) Withdraw(opts *bind.TransactOpts, _id [32]byte) (*types.Transaction, error) {
+	return _Peggy.contract.Transact(opts, "withdraw", _id)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x8e19899e.
+//
+// Solidity: function withdraw(bytes32 _id) returns(bool)
+func (_Peggy *PeggySession) Withdraw(_id [32]byte) (*types.Transaction, error) {
+	return _Peggy.Contract.Withdraw(&_Peggy.TransactOpts, _id)
+}
+
+// Withdraw is a paid mutator transaction binding the contract method 0x8e19899e.
+//
+// Solidity: function withdraw(bytes32 _id) returns(bool)
+func (_Peggy *PeggyTransactorSession) Withdraw(_id [32]byte) (*types.Transaction, error) {
+	return _Peggy.Contract.Withdraw(&_Peggy.TransactOpts, _id)
+}
+
+// PeggyLogLockIterator is returned from FilterLogLock and is used to iterate over the raw logs and unpacked data for LogLock events raised by the Peggy contract.
+type PeggyLogLockIterator struct {
+	Event *PeggyLogLock // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PeggyLogLockIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PeggyLogLock)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PeggyLogLock)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PeggyLogLockIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PeggyLogLockIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PeggyLogLock represents a LogLock event raised by the Peggy contract.
+type PeggyLogLock struct {
+	Id    [32]byte
+	From  common.Address
+	To    []byte
+	Token common.Address
+	Value *big.Int
+	Nonce *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterLogLock is a free log retrieval operation binding the contract event 0xe154a56f2d306d5bbe4ac2379cb0cfc906b23685047a2bd2f5f0a0e810888f72.
+//
+// Solidity: event LogLock(bytes32 _id, address _from, bytes _to, address _token, uint256 _value, uint256 _nonce)
+func (_Peggy *PeggyFilterer) FilterLogLock(opts *bind.FilterOpts) (*PeggyLogLockIterator, error) {
+
+	logs, sub, err := _Peggy.contract.FilterLogs(opts, "LogLock")
+	if err != nil {
+		return nil, err
+	}
+	return &PeggyLogLockIterator{contract: _Peggy.contract, event: "LogLock", logs: logs, sub: sub}, nil
+}
+
+// WatchLogLock is a free log subscription operation binding the contract event 0xe154a56f2d306d5bbe4ac2379cb0cfc906b23685047a2bd2f5f0a0e810888f72.
+//
+// Solidity: event LogLock(bytes32 _id, address _from, bytes _to, address _token, uint256 _value, uint256 _nonce)
+func (_Peggy *PeggyFilterer) WatchLogLock(opts *bind.WatchOpts, sink chan<- *PeggyLogLock) (event.Subscription, error) {
+
+	logs, sub, err := _Peggy.contract.WatchLogs(opts, "LogLock")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PeggyLogLock)
+				if err := _Peggy.contract.UnpackLog(event, "LogLock", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLogLock is a log parse operation binding the contract event 0xe154a56f2d306d5bbe4ac2379cb0cfc906b23685047a2bd2f5f0a0e810888f72.
+//
+// Solidity: event LogLock(bytes32 _id, address _from, bytes _to, address _token, uint256 _value, uint256 _nonce)
+func (_Peggy *PeggyFilterer) ParseLogLock(log types.Log) (*PeggyLogLock, error) {
+	event := new(PeggyLogLock)
+	if err := _Peggy.contract.UnpackLog(event, "LogLock", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PeggyLogLockingActivatedIterator is returned from FilterLogLockingActivated and is used to iterate over the raw logs and unpacked data for LogLockingActivated events raised by the Peggy contract.
+type PeggyLogLockingActivatedIterator struct {
+	Event *PeggyLogLockingActivated // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PeggyLogLockingActivatedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PeggyLogLockingActivated)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PeggyLogLockingActivated)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PeggyLogLockingActivatedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PeggyLogLockingActivatedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PeggyLogLockingActivated represents a LogLockingActivated event raised by the Peggy contract.
+type PeggyLogLockingActivated struct {
+	Time *big.Int
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterLogLockingActivated is a free log retrieval operation binding the contract event 0x9af033c3fdf318cb9968eac8a62b339bd18862abd1703fc74256e9d77cfc95df.
+//
+// Solidity: event LogLockingActivated(uint256 _time)
+func (_Peggy *PeggyFilterer) FilterLogLockingActivated(opts *bind.FilterOpts) (*PeggyLogLockingActivatedIterator, error) {
+
+	logs, sub, err := _Peggy.contract.FilterLogs(opts, "LogLockingActivated")
+	if err != nil {
+		return nil, err
+	}
+	return &PeggyLogLockingActivatedIterator{contract: _Peggy.contract, event: "LogLockingActivated", logs: logs, sub: sub}, nil
+}
+
+// WatchLogLockingActivated is a free log subscription operation binding the contract event 0x9af033c3fdf318cb9968eac8a62b339bd18862abd1703fc74256e9d77cfc95df.
+//
+// Solidity: event LogLockingActivated(uint256 _time)
+func (_Peggy *PeggyFilterer) WatchLogLockingActivated(opts *bind.WatchOpts, sink chan<- *PeggyLogLockingActivated) (event.Subscription, error) {
+
+	logs, sub, err := _Peggy.contract.WatchLogs(opts, "LogLockingActivated")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PeggyLogLockingActivated)
+				if err := _Peggy.contract.UnpackLog(event, "LogLockingActivated", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLogLockingActivated is a log parse operation binding the contract event 0x9af033c3fdf318cb9968eac8a62b339bd18862abd1703fc74256e9d77cfc95df.
+//
+// Solidity: event LogLockingActivated(uint256 _time)
+func (_Peggy *PeggyFilterer) ParseLogLockingActivated(log types.Log) (*PeggyLogLockingActivated, error) {
+	event := new(PeggyLogLockingActivated)
+	if err := _Peggy.contract.UnpackLog(event, "LogLockingActivated", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PeggyLogLockingPausedIterator is returned from FilterLogLockingPaused and is used to iterate over the raw logs and unpacked data for LogLockingPaused events raised by the Peggy contract.
+type PeggyLogLockingPausedIterator struct {
+	Event *PeggyLogLockingPaused // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PeggyLogLockingPausedIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PeggyLogLockingPaused)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PeggyLogLockingPaused)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PeggyLogLockingPausedIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PeggyLogLockingPausedIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PeggyLogLockingPaused represents a LogLockingPaused event raised by the Peggy contract.
+type PeggyLogLockingPaused struct {
+	Time *big.Int
+	Raw  types.Log // Blockchain specific contextual infos
+}
+
+// FilterLogLockingPaused is a free log retrieval operation binding the contract event 0xbebc9a19c81e5697fda01edce5ac5aed2c5a0edb9a972fd5f58ac0419a405a82.
+//
+// Solidity: event LogLockingPaused(uint256 _time)
+func (_Peggy *PeggyFilterer) FilterLogLockingPaused(opts *bind.FilterOpts) (*PeggyLogLockingPausedIterator, error) {
+
+	logs, sub, err := _Peggy.contract.FilterLogs(opts, "LogLockingPaused")
+	if err != nil {
+		return nil, err
+	}
+	return &PeggyLogLockingPausedIterator{contract: _Peggy.contract, event: "LogLockingPaused", logs: logs, sub: sub}, nil
+}
+
+// WatchLogLockingPaused is a free log subscription operation binding the contract event 0xbebc9a19c81e5697fda01edce5ac5aed2c5a0edb9a972fd5f58ac0419a405a82.
+//
+// Solidity: event LogLockingPaused(uint256 _time)
+func (_Peggy *PeggyFilterer) WatchLogLockingPaused(opts *bind.WatchOpts, sink chan<- *PeggyLogLockingPaused) (event.Subscription, error) {
+
+	logs, sub, err := _Peggy.contract.WatchLogs(opts, "LogLockingPaused")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PeggyLogLockingPaused)
+				if err := _Peggy.contract.UnpackLog(event, "LogLockingPaused", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLogLockingPaused is a log parse operation binding the contract event 0xbebc9a19c81e5697fda01edce5ac5aed2c5a0edb9a972fd5f58ac0419a405a82.
+//
+// Solidity: event LogLockingPaused(uint256 _time)
+func (_Peggy *PeggyFilterer) ParseLogLockingPaused(log types.Log) (*PeggyLogLockingPaused, error) {
+	event := new(PeggyLogLockingPaused)
+	if err := _Peggy.contract.UnpackLog(event, "LogLockingPaused", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PeggyLogUnlockIterator is returned from FilterLogUnlock and is used to iterate over the raw logs and unpacked data for LogUnlock events raised by the Peggy contract.
+type PeggyLogUnlockIterator struct {
+	Event *PeggyLogUnlock // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PeggyLogUnlockIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PeggyLogUnlock)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PeggyLogUnlock)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PeggyLogUnlockIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PeggyLogUnlockIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PeggyLogUnlock represents a LogUnlock event raised by the Peggy contract.
+type PeggyLogUnlock struct {
+	Id    [32]byte
+	To    common.Address
+	Token common.Address
+	Value *big.Int
+	Nonce *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterLogUnlock is a free log retrieval operation binding the contract event 0xb3ceeb2ff57376fcabec63d51a010afad847c03e9365f20a168ca66db8b92740.
+//
+// Solidity: event LogUnlock(bytes32 _id, address _to, address _token, uint256 _value, uint256 _nonce)
+func (_Peggy *PeggyFilterer) FilterLogUnlock(opts *bind.FilterOpts) (*PeggyLogUnlockIterator, error) {
+
+	logs, sub, err := _Peggy.contract.FilterLogs(opts, "LogUnlock")
+	if err != nil {
+		return nil, err
+	}
+	return &PeggyLogUnlockIterator{contract: _Peggy.contract, event: "LogUnlock", logs: logs, sub: sub}, nil
+}
+
+// WatchLogUnlock is a free log subscription operation binding the contract event 0xb3ceeb2ff57376fcabec63d51a010afad847c03e9365f20a168ca66db8b92740.
+//
+// Solidity: event LogUnlock(bytes32 _id, address _to, address _token, uint256 _value, uint256 _nonce)
+func (_Peggy *PeggyFilterer) WatchLogUnlock(opts *bind.WatchOpts, sink chan<- *PeggyLogUnlock) (event.Subscription, error) {
+
+	logs, sub, err := _Peggy.contract.WatchLogs(opts, "LogUnlock")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PeggyLogUnlock)
+				if err := _Peggy.contract.UnpackLog(event, "LogUnlock", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLogUnlock is a log parse operation binding the contract event 0xb3ceeb2ff57376fcabec63d51a010afad847c03e9365f20a168ca66db8b92740.
+//
+// Solidity: event LogUnlock(bytes32 _id, address _to, address _token, uint256 _value, uint256 _nonce)
+func (_Peggy *PeggyFilterer) ParseLogUnlock(log types.Log) (*PeggyLogUnlock, error) {
+	event := new(PeggyLogUnlock)
+	if err := _Peggy.contract.UnpackLog(event, "LogUnlock", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
+// PeggyLogWithdrawIterator is returned from FilterLogWithdraw and is used to iterate over the raw logs and unpacked data for LogWithdraw events raised by the Peggy contract.
+type PeggyLogWithdrawIterator struct {
+	Event *PeggyLogWithdraw // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PeggyLogWithdrawIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PeggyLogWithdraw)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PeggyLogWithdraw)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PeggyLogWithdrawIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PeggyLogWithdrawIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PeggyLogWithdraw represents a LogWithdraw event raised by the Peggy contract.
+type PeggyLogWithdraw struct {
+	Id    [32]byte
+	To    common.Address
+	Token common.Address
+	Value *big.Int
+	Nonce *big.Int
+	Raw   types.Log // Blockchain specific contextual infos
+}
+
+// FilterLogWithdraw is a free log retrieval operation binding the contract event 0x9cbca76b94cf51b34c3949f0c925da38fe8dbae8e6761e11389884a9c1354b2c.
+//
+// Solidity: event LogWithdraw(bytes32 _id, address _to, address _token, uint256 _value, uint256 _nonce)
+func (_Peggy *PeggyFilterer) FilterLogWithdraw(opts *bind.FilterOpts) (*PeggyLogWithdrawIterator, error) {
+
+	logs, sub, err := _Peggy.contract.FilterLogs(opts, "LogWithdraw")
+	if err != nil {
+		return nil, err
+	}
+	return &PeggyLogWithdrawIterator{contract: _Peggy.contract, event: "LogWithdraw", logs: logs, sub: sub}, nil
+}
+
+// WatchLogWithdraw is a free log subscription operation binding the contract event 0x9cbca76b94cf51b34c3949f0c925da38fe8dbae8e6761e11389884a9c1354b2c.
+//
+// Solidity: event LogWithdraw(bytes32 _id, address _to, address _token, uint256 _value, uint256 _nonce)
+func (_Peggy *PeggyFilterer) WatchLogWithdraw(opts *bind.WatchOpts, sink chan<- *PeggyLogWithdraw) (event.Subscription, error) {
+
+	logs, sub, err := _Peggy.contract.WatchLogs(opts, "LogWithdraw")
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PeggyLogWithdraw)
+				if err := _Peggy.contract.UnpackLog(event, "LogWithdraw", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLogWithdraw is a log parse operation binding the contract event 0x9cbca76b94cf51b34c3949f0c925da38fe8dbae8e6761e11389884a9c1354b2c.
+//
+// Solidity: event LogWithdraw(bytes32 _id, address _to, address _token, uint256 _value, uint256 _nonce)
+func (_Peggy *PeggyFilterer) ParseLogWithdraw(log types.Log) (*PeggyLogWithdraw, error) {
+	event := new(PeggyLogWithdraw)
+	if err := _Peggy.contract.UnpackLog(event, "LogWithdraw", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
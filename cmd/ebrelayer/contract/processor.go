@@ -0,0 +1,211 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package contract
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+)
+
+// ProcessorMetaData contains all meta data concerning the Processor contract.
+var ProcessorMetaData = &bind.MetaData{
+	ABI: "[{\"constant\":true,\"inputs\":[],\"name\":\"nonce\",\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"payable\":false,\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"payable\":false,\"stateMutability\":\"nonpayable\",\"type\":\"constructor\"}]",
+}
+
+// ProcessorABI is the input ABI used to generate the binding from.
+// Deprecated: Use ProcessorMetaData.ABI instead.
+var ProcessorABI = ProcessorMetaData.ABI
+
+// Processor is an auto generated Go binding around an Ethereum contract.
+type Processor struct {
+	ProcessorCaller     // Read-only binding to the contract
+	ProcessorTransactor // Write-only binding to the contract
+	ProcessorFilterer   // Log filterer for contract events
+}
+
+// ProcessorCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ProcessorCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProcessorTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ProcessorTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProcessorFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ProcessorFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ProcessorSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ProcessorSession struct {
+	Contract     *Processor        // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ProcessorCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ProcessorCallerSession struct {
+	Contract *ProcessorCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts    // Call options to use throughout this session
+}
+
+// ProcessorTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ProcessorTransactorSession struct {
+	Contract     *ProcessorTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// ProcessorRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ProcessorRaw struct {
+	Contract *Processor // Generic contract binding to access the raw methods on
+}
+
+// ProcessorCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ProcessorCallerRaw struct {
+	Contract *ProcessorCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ProcessorTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ProcessorTransactorRaw struct {
+	Contract *ProcessorTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewProcessor creates a new instance of Processor, bound to a specific deployed contract.
+func NewProcessor(address common.Address, backend bind.ContractBackend) (*Processor, error) {
+	contract, err := bindProcessor(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Processor{ProcessorCaller: ProcessorCaller{contract: contract}, ProcessorTransactor: ProcessorTransactor{contract: contract}, ProcessorFilterer: ProcessorFilterer{contract: contract}}, nil
+}
+
+// NewProcessorCaller creates a new read-only instance of Processor, bound to a specific deployed contract.
+func NewProcessorCaller(address common.Address, caller bind.ContractCaller) (*ProcessorCaller, error) {
+	contract, err := bindProcessor(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ProcessorCaller{contract: contract}, nil
+}
+
+// NewProcessorTransactor creates a new write-only instance of Processor, bound to a specific deployed contract.
+func NewProcessorTransactor(address common.Address, transactor bind.ContractTransactor) (*ProcessorTransactor, error) {
+	contract, err := bindProcessor(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ProcessorTransactor{contract: contract}, nil
+}
+
+// NewProcessorFilterer creates a new log filterer instance of Processor, bound to a specific deployed contract.
+func NewProcessorFilterer(address common.Address, filterer bind.ContractFilterer) (*ProcessorFilterer, error) {
+	contract, err := bindProcessor(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ProcessorFilterer{contract: contract}, nil
+}
+
+// bindProcessor binds a generic wrapper to an already deployed contract.
+func bindProcessor(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := abi.JSON(strings.NewReader(ProcessorABI))
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Processor *ProcessorRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Processor.Contract.ProcessorCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Processor *ProcessorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Processor.Contract.ProcessorTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Processor *ProcessorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Processor.Contract.ProcessorTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Processor *ProcessorCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Processor.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Processor *ProcessorTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Processor.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Processor *ProcessorTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Processor.Contract.contract.Transact(opts, method, params...)
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_Processor *ProcessorCaller) Nonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Processor.contract.Call(opts, &out, "nonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_Processor *ProcessorSession) Nonce() (*big.Int, error) {
+	return _Processor.Contract.Nonce(&_Processor.CallOpts)
+}
+
+// Nonce is a free data retrieval call binding the contract method 0xaffed0e0.
+//
+// Solidity: function nonce() view returns(uint256)
+func (_Processor *ProcessorCallerSession) Nonce() (*big.Int, error) {
+	return _Processor.Contract.Nonce(&_Processor.CallOpts)
+}
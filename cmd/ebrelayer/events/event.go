@@ -3,19 +3,19 @@ package events
 // -----------------------------------------------------
 //    Event
 //
-// 		Creates LockEvents from new events on the ethereum
+// 		Creates typed events from new events on the
 //		Ethereum blockchain.
 // -----------------------------------------------------
 
 import (
 	"encoding/hex"
 	"fmt"
-	"log"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
 )
 
 // LockEvent represents a single smart contract event
@@ -28,23 +28,25 @@ type LockEvent struct {
 	Nonce   *big.Int
 }
 
-func NewLockEvent(contractAbi abi.ABI, eventName string, eventData []byte) LockEvent {
-
-	// Load Peggy smart contract abi
-	if eventName != "LogLock" {
-		log.Fatal("Only LogLock events are currently supported.")
-	}
+func NewLockEvent(peggy *contract.PeggyFilterer, vLog types.Log) (LockEvent, error) {
 
 	// Parse the event's attributes as Ethereum network variables
-	event := LockEvent{}
-	err := contractAbi.UnpackIntoInterface(&event, eventName, eventData)
+	parsed, err := peggy.ParseLogLock(vLog)
 	if err != nil {
-	    log.Fatal("Unpacking: ", err)
+		return LockEvent{}, err
+	}
+	event := LockEvent{
+		Id:    parsed.Id,
+		From:  parsed.From,
+		To:    parsed.To,
+		Token: parsed.Token,
+		Value: parsed.Value,
+		Nonce: parsed.Nonce,
 	}
 
 	PrintEvent(event)
 
-	return event
+	return event, nil
 }
 
 func PrintEvent(event LockEvent) {
@@ -59,4 +61,65 @@ func PrintEvent(event LockEvent) {
 	// Print the event's information
 	fmt.Printf("\nEvent ID: %v\nToken: %v\nSender: %v\nRecipient: %v\nValue: %v\nNonce: %v\n\n",
 							id, token, sender, recipient, value, nonce)
-}
\ No newline at end of file
+}
+
+// ReleaseEvent represents a LogWithdraw or LogUnlock event, which release the locked funds of an item
+type ReleaseEvent struct {
+	Id      [32]byte
+	To      common.Address
+	Token   common.Address
+	Value   *big.Int
+	Nonce   *big.Int
+}
+
+func NewReleaseEvent(peggy *contract.PeggyFilterer, eventName string, vLog types.Log) (ReleaseEvent, error) {
+
+	// Parse the event's attributes as Ethereum network variables
+	var event ReleaseEvent
+	switch eventName {
+	case LogWithdraw:
+		parsed, err := peggy.ParseLogWithdraw(vLog)
+		if err != nil {
+			return ReleaseEvent{}, err
+		}
+		event = ReleaseEvent{Id: parsed.Id, To: parsed.To, Token: parsed.Token, Value: parsed.Value, Nonce: parsed.Nonce}
+	case LogUnlock:
+		parsed, err := peggy.ParseLogUnlock(vLog)
+		if err != nil {
+			return ReleaseEvent{}, err
+		}
+		event = ReleaseEvent{Id: parsed.Id, To: parsed.To, Token: parsed.Token, Value: parsed.Value, Nonce: parsed.Nonce}
+	default:
+		return ReleaseEvent{}, fmt.Errorf("%s is not a release event", eventName)
+	}
+
+	fmt.Printf("\nEvent ID: %v\nToken: %v\nReceiver: %v\nValue: %v\nNonce: %v\n\n",
+		hex.EncodeToString(event.Id[:]), event.Token.Hex(), event.To.Hex(), event.Value, event.Nonce)
+
+	return event, nil
+}
+
+// LockingEvent represents a LogLockingPaused or LogLockingActivated event, which stop or resume new locks
+type LockingEvent struct {
+	Time *big.Int
+}
+
+func NewLockingEvent(peggy *contract.PeggyFilterer, eventName string, vLog types.Log) (LockingEvent, error) {
+
+	switch eventName {
+	case LogLockingPaused:
+		parsed, err := peggy.ParseLogLockingPaused(vLog)
+		if err != nil {
+			return LockingEvent{}, err
+		}
+		return LockingEvent{Time: parsed.Time}, nil
+	case LogLockingActivated:
+		parsed, err := peggy.ParseLogLockingActivated(vLog)
+		if err != nil {
+			return LockingEvent{}, err
+		}
+		return LockingEvent{Time: parsed.Time}, nil
+	default:
+		return LockingEvent{}, fmt.Errorf("%s is not a locking event", eventName)
+	}
+}
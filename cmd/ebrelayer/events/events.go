@@ -9,13 +9,18 @@ package events
 
 import (
 	"fmt"
-
+	"sync"
 )
 
 var EventRecords = make(map[string]LockEvent)
 
+// eventRecordsMu guards the records written by the relayers of several contracts
+var eventRecordsMu sync.RWMutex
+
 // Add a validator's address to the official claims list
 func NewEventWrite(txHash string, event LockEvent) bool {
+	eventRecordsMu.Lock()
+	defer eventRecordsMu.Unlock()
 	EventRecords[txHash] = event
 
 	return true
@@ -23,6 +28,8 @@ func NewEventWrite(txHash string, event LockEvent) bool {
 
 // Checks the sessions stored events for this transaction hash
 func IsEventRecorded(txHash string) bool {
+	eventRecordsMu.RLock()
+	defer eventRecordsMu.RUnlock()
 	if EventRecords[txHash].Nonce == nil  {
 		return false
 	}
@@ -30,8 +37,10 @@ func IsEventRecorded(txHash string) bool {
 }
 
 func PrintEventByTx(txHash string) {
-	if IsEventRecorded(txHash) {
-		PrintEvent(EventRecords[txHash])
+	eventRecordsMu.RLock()
+	defer eventRecordsMu.RUnlock()
+	if event, ok := EventRecords[txHash]; ok && event.Nonce != nil {
+		PrintEvent(event)
 	} else {
 		fmt.Printf("\nNo records from this sesson for tx: %v\n", txHash)
 	}
@@ -39,6 +48,8 @@ func PrintEventByTx(txHash string) {
 
 // Prints all the claims made on this event
 func PrintEvents() error {
+	eventRecordsMu.RLock()
+	defer eventRecordsMu.RUnlock()
 
  	// For each claim, print the validator which submitted the claim
   for tx, event := range EventRecords {
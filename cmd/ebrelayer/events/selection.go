@@ -0,0 +1,80 @@
+package events
+
+// -----------------------------------------------------
+//    Selection
+//
+// 		Resolves the peggy events an operator chooses to
+//		relay into the topics of their logs.
+// -----------------------------------------------------
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Names of the peggy events the relayer handles
+const (
+	LogLock             = "LogLock"
+	LogUnlock           = "LogUnlock"
+	LogWithdraw         = "LogWithdraw"
+	LogLockingPaused    = "LogLockingPaused"
+	LogLockingActivated = "LogLockingActivated"
+)
+
+// SupportedEvents lists the peggy events the relayer handles
+var SupportedEvents = []string{LogLock, LogUnlock, LogWithdraw, LogLockingPaused, LogLockingActivated}
+
+// ParseEventSelection resolves a comma separated list of event names or signatures, eg.
+// "LogLock(bytes32,address,bytes,address,uint256,uint256),LogUnlock", into the names of the selected
+// events keyed by the topic of their logs
+func ParseEventSelection(contractAbi abi.ABI, selection string) (map[common.Hash]string, error) {
+	selected := make(map[common.Hash]string)
+	for _, item := range splitSelection(selection) {
+		event, found := findEvent(contractAbi, item)
+		if !found {
+			return nil, fmt.Errorf("unknown event %q, supported events are %s", item,
+				strings.Join(SupportedEvents, ", "))
+		}
+		selected[event.ID] = event.Name
+	}
+	if len(selected) == 0 {
+		return nil, fmt.Errorf("no event selected")
+	}
+	return selected, nil
+}
+
+// findEvent returns the supported event of the ABI with the given name or signature
+func findEvent(contractAbi abi.ABI, nameOrSig string) (abi.Event, bool) {
+	for _, name := range SupportedEvents {
+		event, ok := contractAbi.Events[name]
+		if ok && (event.Name == nameOrSig || event.Sig == nameOrSig) {
+			return event, true
+		}
+	}
+	return abi.Event{}, false
+}
+
+// splitSelection splits a selection on the commas that do not separate the arguments of a signature
+func splitSelection(selection string) []string {
+	var items []string
+	depth, start := 0, 0
+	for i, c := range selection + "," {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				if item := strings.TrimSpace(selection[start:i]); item != "" {
+					items = append(items, item)
+				}
+				start = i + 1
+			}
+		}
+	}
+	return items
+}
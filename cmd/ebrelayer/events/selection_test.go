@@ -0,0 +1,29 @@
+package events
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
+)
+
+func TestParseEventSelection(t *testing.T) {
+	contractAbi := contract.LoadABI()
+
+	// Events are selected by name or by signature
+	selected, err := ParseEventSelection(contractAbi,
+		"LogLock(bytes32,address,bytes,address,uint256,uint256), LogUnlock,LogLockingPaused")
+	require.NoError(t, err)
+	require.Equal(t, 3, len(selected))
+	require.Equal(t, LogLock, selected[contractAbi.Events[LogLock].ID])
+	require.Equal(t, LogUnlock, selected[contractAbi.Events[LogUnlock].ID])
+	require.Equal(t, LogLockingPaused, selected[contractAbi.Events[LogLockingPaused].ID])
+
+	_, err = ParseEventSelection(contractAbi, "LogLock(bytes32)")
+	require.Error(t, err)
+	_, err = ParseEventSelection(contractAbi, "Transfer")
+	require.Error(t, err)
+	_, err = ParseEventSelection(contractAbi, " , ")
+	require.Error(t, err)
+}
@@ -10,25 +10,52 @@ package main
 import (
 	"encoding/hex"
 	"fmt"
+	"net/http"
 	"os"
+	"strings"
 
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/rpc"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
 	amino "github.com/tendermint/go-amino"
 	"github.com/tendermint/tendermint/libs/cli"
 
-	// "golang.org/x/crypto"
-
 	app "github.com/pumpkinzomb/cosmos-ethereum-bridge"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/checkpoint"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/config"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/events"
 	relayer "github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/relayer"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge"
 )
 
 const (
 	storeAcc       = "acc"
 	routeEthbridge = "ethbridge"
+
+	flagValidator = "validator"
+	flagFromBlock = "from-block"
+	flagToBlock   = "to-block"
+
+	flagPollInterval  = "poll-interval"
+	flagConfirmations = "confirmations"
+
+	flagWeb3Provider    = "web3-provider"
+	flagContractAddress = "contract-address"
+
+	flagRelayerConfig = "relayer-config"
+	flagNetwork       = "network"
+
+	flagMetricsListenAddr = "metrics-listen-addr"
+	metricsNamespace      = "ebrelayer"
 )
 
 var defaultCLIHome = os.ExpandEnv("$HOME/.ebcli")
@@ -50,6 +77,10 @@ func init() {
 	rootCmd.AddCommand(
 		rpc.StatusCommand(),
 		initRelayerCmd(),
+		startRelayerCmd(),
+		backfillCmd(),
+		reorgsCmd(),
+		client.GetCommands(refundsCmd())[0],
 	)
 
 	executor := cli.PrepareMainCmd(rootCmd, "EBRELAYER", defaultCLIHome)
@@ -67,10 +98,20 @@ var rootCmd = &cobra.Command{
 
 func initRelayerCmd() *cobra.Command {
 	initRelayerCmd := &cobra.Command{
-		Use:   "init chain-id web3-provider contract-address event-signature validatorFromName",
-		Short: "Initalizes a web socket which streams live events from a smart contract",
-		RunE:  RunRelayerCmd,
+		Use:   "init chain-id web3-provider contract-address event-signatures validatorFromName",
+		Short: "Initalizes a web socket or HTTP polling stream of live events from a smart contract, resuming from the last checkpoint",
+		Long: `Initalizes a web socket or HTTP polling stream of live events from a smart contract, resuming from the last checkpoint.
+
+event-signatures is a comma separated list of the names or signatures of the events to relay, among ` +
+			strings.Join(events.SupportedEvents, ", ") + `. For example:
+
+ebrelayer init testing wss://ropsten.infura.io/ws 3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb "LogLock,LogWithdraw,LogUnlock" validator`,
+		RunE: RunRelayerCmd,
 	}
+	initRelayerCmd.Flags().String(flagValidator, "", "Address of the validator to claim for when validatorFromName is its registered feeder")
+	initRelayerCmd.Flags().Duration(flagPollInterval, relayer.DefaultPollInterval, "How often an HTTP web3-provider is polled for new events")
+	initRelayerCmd.Flags().Uint64(flagConfirmations, relayer.DefaultConfirmations, "Number of blocks mined on top of an event's block before it is relayed")
+	initRelayerCmd.Flags().String(flagMetricsListenAddr, "", "Address to serve prometheus metrics on, eg. :26661. Metrics are disabled if not set")
 
 	return initRelayerCmd
 }
@@ -88,10 +129,22 @@ func RunRelayerCmd(cmd *cobra.Command, args []string) error {
 
 	// Parse ethereum provider
 	ethereumProvider := args[1]
-	if !relayer.IsWebsocketURL(ethereumProvider) {
+	if !relayer.IsWebsocketURL(ethereumProvider) && !relayer.IsHTTPURL(ethereumProvider) {
 		return fmt.Errorf("Invalid web3-provider: %v", ethereumProvider)
 	}
 
+	// Parse how often an HTTP provider is polled
+	pollInterval, err := cmd.Flags().GetDuration(flagPollInterval)
+	if err != nil || pollInterval <= 0 {
+		return fmt.Errorf("Invalid poll-interval: %v", pollInterval)
+	}
+
+	// Parse how deep events must be before they are relayed
+	confirmations, err := cmd.Flags().GetUint64(flagConfirmations)
+	if err != nil {
+		return fmt.Errorf("Invalid confirmations: %v", err)
+	}
+
 	// Parse the address of the deployed contract
 	bytesContractAddress, err := hex.DecodeString(args[2])
 	if err != nil {
@@ -99,24 +152,48 @@ func RunRelayerCmd(cmd *cobra.Command, args []string) error {
 	}
 	contractAddress := common.BytesToAddress(bytesContractAddress)
 
-	// Parse the event signature for the subscription
-	eventSig := "0xe154a56f2d306d5bbe4ac2379cb0cfc906b23685047a2bd2f5f0a0e810888f72"
-	// eventSig := crypto.Keccak256Hash([]byte(args[3]))
-	if eventSig == "" {
-		return fmt.Errorf("Invalid event-signature: %v", eventSig)
+	// Parse the names or signatures of the events to relay, which are resolved against the contract's ABI
+	eventSelection := args[3]
+	if eventSelection == "" {
+		return fmt.Errorf("Invalid event-signatures: %v", eventSelection)
 	}
 
 	// Parse the validator running the relayer service
 	validatorFrom := args[4]
 
+	// Parse the validator the relayer's key feeds claims for, if it is not the key's own
+	var validator sdk.AccAddress
+	if validatorFlag, _ := cmd.Flags().GetString(flagValidator); validatorFlag != "" {
+		validator, err = sdk.AccAddressFromBech32(validatorFlag)
+		if err != nil {
+			return fmt.Errorf("Invalid validator: %v", validatorFlag)
+		}
+	}
+
+	// Open the checkpoints of the logs already relayed, kept under the relayer home
+	checkpoints, err := checkpoint.OpenStore(viper.GetString(cli.HomeFlag))
+	if err != nil {
+		return fmt.Errorf("Failed to open checkpoints: %v", err)
+	}
+	defer checkpoints.Close()
+
+	// Serve the connection state of the relayer to prometheus
+	listenAddr, _ := cmd.Flags().GetString(flagMetricsListenAddr)
+	metrics := serveMetrics(listenAddr)
+
 	// Initialize the relayer
 	initErr := relayer.InitRelayer(
 		appCodec,
 		chainId,
 		ethereumProvider,
 		contractAddress,
-		eventSig,
-		validatorFrom)
+		eventSelection,
+		validatorFrom,
+		validator,
+		checkpoints,
+		metrics,
+		pollInterval,
+		confirmations)
 
 	if initErr != nil {
 		fmt.Printf("%v", initErr)
@@ -126,6 +203,248 @@ func RunRelayerCmd(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+func startRelayerCmd() *cobra.Command {
+	startRelayerCmd := &cobra.Command{
+		Use:   "start",
+		Short: "Relays the events of every contract declared in the relayer config, resuming from their last checkpoints",
+		Long: `Relays the events of every contract declared in the relayer config, resuming from their last checkpoints.
+
+The config is read from relayer.toml or relayer.yaml in the config directory of the home, eg. $HOME/.ebcli/config/relayer.toml,
+or from the file given with --relayer-config.`,
+		Args: cobra.NoArgs,
+		RunE: RunStartRelayerCmd,
+	}
+	startRelayerCmd.Flags().String(flagRelayerConfig, "", "Path of the relayer config, instead of the one under the home")
+
+	return startRelayerCmd
+}
+
+// RunStartRelayerCmd relays the events of the contracts of every network of the relayer config concurrently
+func RunStartRelayerCmd(cmd *cobra.Command, args []string) error {
+	home := viper.GetString(cli.HomeFlag)
+
+	var relayerConfig config.Config
+	var err error
+	if path, _ := cmd.Flags().GetString(flagRelayerConfig); path != "" {
+		relayerConfig, err = config.LoadFile(path)
+	} else {
+		relayerConfig, err = config.Load(home)
+	}
+	if err != nil {
+		return fmt.Errorf("Failed to load relayer config: %v", err)
+	}
+
+	// Claims are broadcast to the config's node, with its gas settings
+	if err := relayerConfig.Apply(); err != nil {
+		return err
+	}
+	validator, err := relayerConfig.ValidatorAddress()
+	if err != nil {
+		return err
+	}
+
+	checkpoints, err := checkpoint.OpenStore(home)
+	if err != nil {
+		return fmt.Errorf("Failed to open checkpoints: %v", err)
+	}
+	defer checkpoints.Close()
+
+	metrics := serveMetrics(relayerConfig.MetricsListenAddr)
+
+	// The key is unlocked once for all the contracts, whose claims are submitted through a single queue
+	signer, err := relayer.UnlockSigner(relayerConfig.KeyName, validator)
+	if err != nil {
+		return err
+	}
+	queue, err := relayer.StartQueue(appCodec, relayerConfig.ChainID, signer, checkpoints.DB())
+	if err != nil {
+		return err
+	}
+
+	targets := relayerConfig.Targets()
+	for _, target := range targets {
+		fmt.Printf("Relaying %s from %s\n", target, target.Provider)
+	}
+	return relayer.RelayTargets(queue, signer.Validator, targets, checkpoints, metrics)
+}
+
+// serveMetrics serves the metrics of the relayer to prometheus on an address, or returns no-op metrics if the
+// address is empty
+func serveMetrics(listenAddr string) *relayer.Metrics {
+	if listenAddr == "" {
+		return relayer.NopMetrics()
+	}
+	go func() {
+		if err := http.ListenAndServe(listenAddr, promhttp.Handler()); err != nil {
+			fmt.Printf("Metrics server error: %v", err)
+		}
+	}()
+	return relayer.PrometheusMetrics(metricsNamespace)
+}
+
+func backfillCmd() *cobra.Command {
+	backfillCmd := &cobra.Command{
+		Use:   "backfill chain-id web3-provider contract-address validatorFromName",
+		Short: "Replays the lock events of a block range and relays the claims the validator has not made yet",
+		RunE:  RunBackfillCmd,
+	}
+	backfillCmd.Flags().Uint64(flagFromBlock, 0, "First ethereum block to replay")
+	backfillCmd.Flags().Uint64(flagToBlock, 0, "Last ethereum block to replay, the latest block if not set")
+	backfillCmd.Flags().String(flagValidator, "", "Address of the validator to claim for when validatorFromName is its registered feeder")
+
+	return backfillCmd
+}
+
+// RunBackfillCmd relays the missing claims of the lock events emitted over a range of blocks
+func RunBackfillCmd(cmd *cobra.Command, args []string) error {
+	if len(args) != 4 {
+		return fmt.Errorf("Expected 4 arguments, got %v", len(args))
+	}
+
+	chainId := args[0]
+	if chainId == "" {
+		return fmt.Errorf("Invalid chain-id: %v", chainId)
+	}
+
+	ethereumProvider := args[1]
+
+	bytesContractAddress, err := hex.DecodeString(args[2])
+	if err != nil {
+		return fmt.Errorf("Invalid contract-address: %v", bytesContractAddress)
+	}
+	contractAddress := common.BytesToAddress(bytesContractAddress)
+
+	validatorFrom := args[3]
+
+	var validator sdk.AccAddress
+	if validatorFlag, _ := cmd.Flags().GetString(flagValidator); validatorFlag != "" {
+		validator, err = sdk.AccAddressFromBech32(validatorFlag)
+		if err != nil {
+			return fmt.Errorf("Invalid validator: %v", validatorFlag)
+		}
+	}
+
+	fromBlock, _ := cmd.Flags().GetUint64(flagFromBlock)
+	toBlock, _ := cmd.Flags().GetUint64(flagToBlock)
+
+	return relayer.Backfill(appCodec, chainId, ethereumProvider, contractAddress, validatorFrom, validator,
+		fromBlock, toBlock)
+}
+
+func reorgsCmd() *cobra.Command {
+	reorgsCmd := &cobra.Command{
+		Use:   "reorgs contract-address",
+		Short: "Lists the relayed events of a contract that were later removed from ethereum by a reorg",
+		Args:  cobra.ExactArgs(1),
+		RunE:  RunReorgsCmd,
+	}
+	reorgsCmd.Flags().String(flagNetwork, "", "Network of the contract in the relayer config, if it is relayed by ebrelayer start")
+
+	return reorgsCmd
+}
+
+// RunReorgsCmd prints the reorged events recorded by the relayer of a contract
+func RunReorgsCmd(cmd *cobra.Command, args []string) error {
+	bytesContractAddress, err := hex.DecodeString(args[0])
+	if err != nil {
+		return fmt.Errorf("Invalid contract-address: %v", bytesContractAddress)
+	}
+	contractAddress := common.BytesToAddress(bytesContractAddress)
+
+	checkpoints, err := checkpoint.OpenStore(viper.GetString(cli.HomeFlag))
+	if err != nil {
+		return fmt.Errorf("Failed to open checkpoints: %v", err)
+	}
+	defer checkpoints.Close()
+
+	network, _ := cmd.Flags().GetString(flagNetwork)
+	reorgs, err := checkpoints.ForNetwork(network).Reorgs(contractAddress)
+	if err != nil {
+		return err
+	}
+	for _, reorg := range reorgs {
+		fmt.Println(reorg)
+	}
+
+	return nil
+}
+
+func refundsCmd() *cobra.Command {
+	refundsCmd := &cobra.Command{
+		Use:   "refunds",
+		Short: "Lists the peggy items whose lock prophecy failed with the unlock call that returns them to their sender",
+		Args:  cobra.NoArgs,
+		RunE:  RunRefundsCmd,
+	}
+	refundsCmd.Flags().String(flagWeb3Provider, "", "Ethereum provider to check the items on, skipping those no longer locked")
+	refundsCmd.Flags().String(flagContractAddress, "", "Address of the peggy contract to check the items on")
+
+	return refundsCmd
+}
+
+// RunRefundsCmd queries the refund queue and prints, for each item, the calldata of
+// the peggy unlock that the contract's relayer account should send to refund it
+func RunRefundsCmd(cmd *cobra.Command, args []string) error {
+	cliCtx := context.NewCLIContext().WithCodec(appCodec)
+
+	// With a provider, each item is checked on the contract before its unlock is printed
+	var peggy *contract.PeggyCaller
+	if provider, _ := cmd.Flags().GetString(flagWeb3Provider); provider != "" {
+		contractAddress, _ := cmd.Flags().GetString(flagContractAddress)
+		bytesContractAddress, err := hex.DecodeString(contractAddress)
+		if err != nil || len(bytesContractAddress) != common.AddressLength {
+			return fmt.Errorf("Invalid contract-address: %v", contractAddress)
+		}
+		client, err := ethclient.Dial(provider)
+		if err != nil {
+			return fmt.Errorf("Error dialing web3-provider: %v", err)
+		}
+		defer client.Close()
+		peggy, err = contract.NewPeggyCaller(common.BytesToAddress(bytesContractAddress), client)
+		if err != nil {
+			return err
+		}
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", routeEthbridge, ethbridge.QueryRefunds)
+	res, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return err
+	}
+
+	var refunds ethbridge.Refunds
+	appCodec.MustUnmarshalJSON(res, &refunds)
+
+	contractABI := contract.LoadABI()
+	for _, refund := range refunds {
+		data, err := contract.PackUnlock(contractABI, refund.ItemID)
+		if err != nil {
+			return err
+		}
+		if peggy != nil {
+			id, _ := contract.ParseItemID(refund.ItemID)
+			locked, err := peggy.GetStatus(&bind.CallOpts{}, id)
+			if err != nil {
+				return err
+			}
+			if !locked {
+				fmt.Printf("%v\nAlready unlocked on ethereum\n\n", refund)
+				continue
+			}
+			sender, _, token, amount, _, err := peggy.ViewItem(&bind.CallOpts{}, id)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("%v\nLocked:  %v of token %v from %v\n", refund, amount, token.Hex(), sender.Hex())
+		} else {
+			fmt.Printf("%v\n", refund)
+		}
+		fmt.Printf("Unlock:  0x%v\n\n", hex.EncodeToString(data))
+	}
+
+	return nil
+}
+
 func main() {
 	err := rootCmd.Execute()
 	if err != nil {
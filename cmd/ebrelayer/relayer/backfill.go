@@ -0,0 +1,144 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Backfill
+//
+//    Replays the lock events of a block range and relays the
+//    claims this validator is missing, after an outage or
+//    when a new validator joins.
+// ------------------------------------------------------------
+
+import (
+	"context"
+	"fmt"
+
+	amino "github.com/tendermint/go-amino"
+	dbm "github.com/tendermint/tendermint/libs/db"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/events"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/txs"
+	ethbridgetypes "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// Backfill relays the claims of the LogLock events emitted by the contract from fromBlock to toBlock that the
+// validator has not claimed yet. Prophecies that are already finalized are skipped too. A toBlock of zero
+// backfills up to the latest block.
+func Backfill(cdc *amino.Codec, chainId string, provider string, contractAddress common.Address,
+	validatorFrom string, validator sdk.AccAddress, fromBlock uint64, toBlock uint64) error {
+
+	signer, err := UnlockSigner(validatorFrom, validator)
+	if err != nil {
+		return err
+	}
+	validator = signer.Validator
+
+	client, err := ethclient.Dial(provider)
+	if err != nil {
+		return fmt.Errorf("error dialing ethereum client: %s", err)
+	}
+	defer client.Close()
+
+	if toBlock == 0 {
+		toBlock, err = client.BlockNumber(context.Background())
+		if err != nil {
+			return err
+		}
+	}
+	if fromBlock > toBlock {
+		return fmt.Errorf("from block %d is after to block %d", fromBlock, toBlock)
+	}
+
+	contractABI := contract.LoadABI()
+	peggy, err := contract.NewPeggyFilterer(contractAddress, client)
+	if err != nil {
+		return err
+	}
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{contractAddress},
+		Topics:    [][]common.Hash{{contractABI.Events[events.LogLock].ID}},
+	}
+	logs, err := FilterLogs(client, query, fromBlock, toBlock)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("\nFound %d lock events from block %d to block %d\n", len(logs), fromBlock, toBlock)
+
+	// The claims are submitted through a queue of their own, which a live relayer of the same key does not share,
+	// so its submissions are not persisted and the backfill waits for them to be confirmed
+	queue, err := StartQueue(cdc, chainId, signer, dbm.NewMemDB())
+	if err != nil {
+		return err
+	}
+
+	// Locks are claimed in the denomination the chain maps their token to
+	params, err := txs.QueryParams(cdc)
+	if err != nil {
+		return err
+	}
+
+	// Missing claims are relayed in batches of the same ethereum block, like the live relayer does
+	var batch []ethbridgetypes.EthBridgeClaim
+	var batchBlock uint64
+	relayed, claimed, finalized := 0, 0, 0
+	relayBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if err := txs.RelayEvents(queue, batch); err != nil {
+			return err
+		}
+		relayed += len(batch)
+		batch = nil
+		return nil
+	}
+
+	for _, vLog := range logs {
+		event, err := events.NewLockEvent(peggy, vLog)
+		if err != nil {
+			fmt.Printf("Error: %s", err)
+			continue
+		}
+		claim, err := txs.ParsePayload(validator, vLog.TxHash, vLog.Index, &event, params.TokenDenoms)
+		if err != nil {
+			fmt.Printf("Error: %s", err)
+			continue
+		}
+
+		reason, err := txs.PreflightClaim(cdc, claim)
+		if err != nil {
+			return err
+		}
+		switch reason {
+		case txs.SkipAlreadyClaimed:
+			claimed++
+			continue
+		case txs.SkipFinalized:
+			fmt.Printf("\nSkipping item %s: %s", claim.ItemID, reason)
+			finalized++
+			continue
+		}
+
+		if vLog.BlockNumber != batchBlock || len(batch) == ethbridgetypes.MaxClaimsPerBatch {
+			if err := relayBatch(); err != nil {
+				return err
+			}
+			batchBlock = vLog.BlockNumber
+		}
+		batch = append(batch, claim)
+	}
+	if err := relayBatch(); err != nil {
+		return err
+	}
+	queue.Drain()
+
+	fmt.Printf("\nBackfill complete: %d claims relayed, %d already claimed, %d prophecies already finalized\n",
+		relayed, claimed, finalized)
+	return nil
+}
@@ -0,0 +1,97 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Confirm
+//
+//    Buffers the logs of recent blocks until they are deep
+//    enough in the chain to be safe from reorgs.
+// ------------------------------------------------------------
+
+import (
+	"sort"
+
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// DefaultConfirmations is the number of blocks mined on top of a log's block before the log is relayed
+const DefaultConfirmations = 6
+
+// ConfirmationBuffer holds the logs waiting for their confirmations, in chain order
+type ConfirmationBuffer struct {
+	depth uint64
+	logs  []types.Log
+}
+
+// NewConfirmationBuffer returns a buffer releasing logs once depth blocks are mined on top of theirs
+func NewConfirmationBuffer(depth uint64) *ConfirmationBuffer {
+	return &ConfirmationBuffer{depth: depth}
+}
+
+// Add buffers a log, returning false if it is already buffered
+func (buffer *ConfirmationBuffer) Add(vLog types.Log) bool {
+	i := sort.Search(len(buffer.logs), func(i int) bool {
+		return !logBefore(buffer.logs[i], vLog)
+	})
+	for j := i; j < len(buffer.logs) && !logBefore(vLog, buffer.logs[j]); j++ {
+		if sameLog(buffer.logs[j], vLog) {
+			return false
+		}
+	}
+	buffer.logs = append(buffer.logs, types.Log{})
+	copy(buffer.logs[i+1:], buffer.logs[i:])
+	buffer.logs[i] = vLog
+	return true
+}
+
+// Remove cancels a buffered log, returning false if it was not buffered
+func (buffer *ConfirmationBuffer) Remove(vLog types.Log) bool {
+	for i, buffered := range buffer.logs {
+		if sameLog(buffered, vLog) {
+			buffer.logs = append(buffer.logs[:i], buffer.logs[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveBlock cancels every buffered log of a block
+func (buffer *ConfirmationBuffer) RemoveBlock(blockNumber uint64) {
+	kept := buffer.logs[:0]
+	for _, buffered := range buffer.logs {
+		if buffered.BlockNumber != blockNumber {
+			kept = append(kept, buffered)
+		}
+	}
+	buffer.logs = kept
+}
+
+// Confirmed returns the buffered logs that have their confirmations at a head block, in chain order. They stay
+// buffered until they are removed.
+func (buffer *ConfirmationBuffer) Confirmed(head uint64) []types.Log {
+	var confirmed []types.Log
+	for _, buffered := range buffer.logs {
+		if buffered.BlockNumber+buffer.depth > head {
+			break
+		}
+		confirmed = append(confirmed, buffered)
+	}
+	return confirmed
+}
+
+// Len returns the number of buffered logs
+func (buffer *ConfirmationBuffer) Len() int {
+	return len(buffer.logs)
+}
+
+func logBefore(a types.Log, b types.Log) bool {
+	if a.BlockNumber != b.BlockNumber {
+		return a.BlockNumber < b.BlockNumber
+	}
+	return a.Index < b.Index
+}
+
+// sameLog returns whether two logs are the same log of the same block, a log of a reorged block being distinct
+// from the log at its position in the new block
+func sameLog(a types.Log, b types.Log) bool {
+	return a.BlockHash == b.BlockHash && a.Index == b.Index
+}
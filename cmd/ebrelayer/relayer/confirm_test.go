@@ -0,0 +1,43 @@
+package relayer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+func testLog(blockNumber uint64, index uint, blockHash string) types.Log {
+	return types.Log{BlockNumber: blockNumber, Index: index, BlockHash: common.HexToHash(blockHash)}
+}
+
+func TestConfirmationBuffer(t *testing.T) {
+	buffer := NewConfirmationBuffer(3)
+
+	require.True(t, buffer.Add(testLog(12, 0, "0x12")))
+	require.True(t, buffer.Add(testLog(10, 1, "0x10")))
+	require.True(t, buffer.Add(testLog(10, 0, "0x10")))
+	require.False(t, buffer.Add(testLog(10, 1, "0x10")))
+	require.Equal(t, 3, buffer.Len())
+
+	// Logs are released in chain order once 3 blocks are mined on top of theirs
+	require.Empty(t, buffer.Confirmed(12))
+	require.Equal(t, []types.Log{testLog(10, 0, "0x10"), testLog(10, 1, "0x10")}, buffer.Confirmed(14))
+	require.Len(t, buffer.Confirmed(15), 3)
+
+	// A removed log cancels its buffered entry, but not the log at its position in the new block
+	require.True(t, buffer.Add(testLog(12, 0, "0x12b")))
+	require.True(t, buffer.Remove(testLog(12, 0, "0x12")))
+	require.False(t, buffer.Remove(testLog(12, 0, "0x12")))
+	require.Equal(t, testLog(12, 0, "0x12b"), buffer.Confirmed(15)[2])
+
+	buffer.RemoveBlock(10)
+	require.Equal(t, []types.Log{testLog(12, 0, "0x12b")}, buffer.Confirmed(15))
+
+	// Without a depth logs are released at once
+	require.Len(t, NewConfirmationBuffer(0).Confirmed(0), 0)
+	immediate := NewConfirmationBuffer(0)
+	immediate.Add(testLog(20, 0, "0x20"))
+	require.Len(t, immediate.Confirmed(20), 1)
+}
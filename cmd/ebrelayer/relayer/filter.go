@@ -0,0 +1,39 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Filter
+//
+//    Fetches the historical logs of a block range, split into
+//    requests small enough for Ethereum providers to serve.
+// ------------------------------------------------------------
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+)
+
+// filterLogsBlockRange is the most blocks requested by a single eth_getLogs call
+const filterLogsBlockRange = 2000
+
+// FilterLogs returns the logs matching the query from fromBlock to toBlock inclusive, in chain order
+func FilterLogs(client *ethclient.Client, query ethereum.FilterQuery, fromBlock uint64, toBlock uint64) ([]types.Log, error) {
+	var logs []types.Log
+	for start := fromBlock; start <= toBlock; start += filterLogsBlockRange {
+		end := start + filterLogsBlockRange - 1
+		if end > toBlock {
+			end = toBlock
+		}
+		query.FromBlock = new(big.Int).SetUint64(start)
+		query.ToBlock = new(big.Int).SetUint64(end)
+		chunk, err := client.FilterLogs(context.Background(), query)
+		if err != nil {
+			return nil, err
+		}
+		logs = append(logs, chunk...)
+	}
+	return logs, nil
+}
@@ -0,0 +1,98 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Metrics
+//
+//    Exposes the state of the relayer's ethereum connection
+//    and of the logs it processed.
+// ------------------------------------------------------------
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/go-kit/kit/metrics"
+	"github.com/go-kit/kit/metrics/discard"
+	"github.com/go-kit/kit/metrics/prometheus"
+	stdprometheus "github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// MetricsSubsystem is a subsystem shared by all metrics exposed by this package.
+	MetricsSubsystem = "relayer"
+
+	networkLabel  = "network"
+	contractLabel = "contract"
+)
+
+// Metrics contains metrics exposed by this package.
+type Metrics struct {
+	// Whether the relayer is subscribed to the contract's logs (1) or not (0).
+	Connected metrics.Gauge
+	// Number of times the relayer reconnected after losing its subscription.
+	Reconnects metrics.Counter
+	// Block of the last log the relayer fully processed.
+	LastProcessedBlock metrics.Gauge
+	// Number of logs waiting for their confirmations.
+	PendingLogs metrics.Gauge
+	// Number of already processed logs that were removed by a reorg.
+	ReorgedLogs metrics.Counter
+}
+
+// PrometheusMetrics returns Metrics build using Prometheus client library, labelled by network and contract.
+func PrometheusMetrics(namespace string) *Metrics {
+	labels := []string{networkLabel, contractLabel}
+	return &Metrics{
+		Connected: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "connected",
+			Help:      "Whether the relayer is subscribed to the contract's logs.",
+		}, labels),
+		Reconnects: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "reconnects",
+			Help:      "Number of times the relayer reconnected to ethereum.",
+		}, labels),
+		LastProcessedBlock: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "last_processed_block",
+			Help:      "Block of the last log the relayer fully processed.",
+		}, labels),
+		PendingLogs: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pending_logs",
+			Help:      "Number of logs waiting for their confirmations.",
+		}, labels),
+		ReorgedLogs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "reorged_logs",
+			Help:      "Number of already processed logs that were removed by a reorg.",
+		}, labels),
+	}
+}
+
+// NopMetrics returns no-op Metrics.
+func NopMetrics() *Metrics {
+	return &Metrics{
+		Connected:          discard.NewGauge(),
+		Reconnects:         discard.NewCounter(),
+		LastProcessedBlock: discard.NewGauge(),
+		PendingLogs:        discard.NewGauge(),
+		ReorgedLogs:        discard.NewCounter(),
+	}
+}
+
+// ForTarget returns the metrics of a single contract of a network
+func (m *Metrics) ForTarget(network string, contract common.Address) *Metrics {
+	labels := []string{networkLabel, network, contractLabel, contract.Hex()}
+	return &Metrics{
+		Connected:          m.Connected.With(labels...),
+		Reconnects:         m.Reconnects.With(labels...),
+		LastProcessedBlock: m.LastProcessedBlock.With(labels...),
+		PendingLogs:        m.PendingLogs.With(labels...),
+		ReorgedLogs:        m.ReorgedLogs.With(labels...),
+	}
+}
@@ -3,8 +3,8 @@ package relayer
 // ------------------------------------------------------------
 //    Network
 //
-//    Validates input and initializes a websocket Ethereum
-//    client.
+//    Validates input and initializes a websocket or HTTP
+//    Ethereum client.
 // ------------------------------------------------------------
 
 import (
@@ -28,10 +28,20 @@ func IsWebsocketURL(rawurl string) bool {
 	return false
 }
 
+// IsHTTPURL return true if the given URL is an HTTP URL
+func IsHTTPURL(rawurl string) bool {
+	u, err := url.Parse(rawurl)
+	if err != nil {
+		log.Infof("Error while parsing URL: %v", err)
+		return false
+	}
+	return u.Scheme == "http" || u.Scheme == "https"
+}
+
 // SetupWebsocketEthClient returns an websocket ethclient if URL is valid.
 func SetupWebsocketEthClient(ethURL string) (*ethclient.Client, error) {
 	if ethURL == "" {
-		return nil, nil
+		return nil, fmt.Errorf("missing websocket eth client URL")
 	}
 
 	if !IsWebsocketURL(ethURL) {
@@ -43,7 +53,21 @@ func SetupWebsocketEthClient(ethURL string) (*ethclient.Client, error) {
 
 	client, err := ethclient.Dial(ethURL)
 	if err != nil {
-		return nil, fmt.Errorf("error dialing websocket client")
+		return nil, fmt.Errorf("error dialing websocket client: %s", err)
+	}
+
+	return client, nil
+}
+
+// SetupHTTPEthClient returns an HTTP ethclient if URL is valid.
+func SetupHTTPEthClient(ethURL string) (*ethclient.Client, error) {
+	if !IsHTTPURL(ethURL) {
+		return nil, fmt.Errorf("invalid HTTP eth client URL: %v", ethURL)
+	}
+
+	client, err := ethclient.Dial(ethURL)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing HTTP client: %s", err)
 	}
 
 	return client, nil
@@ -10,111 +10,538 @@ package relayer
 // -----------------------------------------------------
 
 import (
-	"context"
 	"fmt"
-	"log"
+	"time"
 
 	amino "github.com/tendermint/go-amino"
+	dbm "github.com/tendermint/tendermint/libs/db"
 
 	sdkContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/keys"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
 
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/checkpoint"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/contract"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/events"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/txs"
+	ethbridgetypes "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
 )
 
+const (
+	// batchFlushDelay is how long the relayer waits for more logs of a block before relaying its batch of claims
+	batchFlushDelay = 2 * time.Second
+
+	// minReconnectDelay and maxReconnectDelay bound the exponential backoff between reconnection attempts
+	minReconnectDelay = 1 * time.Second
+	maxReconnectDelay = 2 * time.Minute
+)
+
+// Signer is the unlocked key that signs the claims of a validator
+type Signer struct {
+	Address    sdk.AccAddress
+	Name       string
+	Passphrase string
+	// Validator the claims are made for, the key's own validator unless the key is the feeder of another one
+	Validator sdk.AccAddress
+}
+
+// UnlockSigner asks for the passphrase of a key and returns the signer of the claims of a validator
+func UnlockSigner(validatorFrom string, validator sdk.AccAddress) (Signer, error) {
+	validatorAddress, validatorName, passphrase, err := unlockValidatorKey(validatorFrom)
+	if err != nil {
+		return Signer{}, err
+	}
+
+	// Claims are made for the key's own validator unless the key is the feeder of another one
+	if validator.Empty() {
+		validator = validatorAddress
+	}
+
+	return Signer{
+		Address:    validatorAddress,
+		Name:       validatorName,
+		Passphrase: passphrase,
+		Validator:  validator,
+	}, nil
+}
+
+// StartQueue starts the queue that submits the claims of the signer, keeping its unconfirmed submissions in the
+// database
+func StartQueue(cdc *amino.Codec, chainId string, signer Signer, db dbm.DB) (*txs.Queue, error) {
+	queue, err := txs.NewQueue(cdc, chainId, signer.Address, signer.Name, signer.Passphrase, db)
+	if err != nil {
+		return nil, err
+	}
+	go queue.Run()
+	return queue, nil
+}
+
+// Target is a peggy contract on an ethereum network, and the events of it that are relayed
+type Target struct {
+	// Network names the ethereum network of the contract, and is empty for a relayer of a single contract
+	Network       string
+	Provider      string
+	Contract      common.Address
+	Events        string
+	PollInterval  time.Duration
+	Confirmations uint64
+}
+
+func (target Target) String() string {
+	if target.Network == "" {
+		return target.Contract.Hex()
+	}
+	return fmt.Sprintf("%s/%s", target.Network, target.Contract.Hex())
+}
+
 // -------------------------------------------------------------------------
 // Starts an event listener on a specific network, contract, and event
 // -------------------------------------------------------------------------
 
 func InitRelayer(cdc *amino.Codec, chainId string, provider string,
-	contractAddress common.Address, eventSig string,
-	validatorFrom string) error {
+	contractAddress common.Address, eventSelection string,
+	validatorFrom string, validator sdk.AccAddress, checkpoints *checkpoint.Store, metrics *Metrics,
+	pollInterval time.Duration, confirmations uint64) error {
 
-	validatorAddress, validatorName, err := sdkContext.GetFromFields(validatorFrom, false)
+	signer, err := UnlockSigner(validatorFrom, validator)
 	if err != nil {
-		fmt.Printf("failed to get from fields: %v", err)
 		return err
 	}
 
-	passphrase, err := keys.GetPassphrase(validatorFrom)
+	queue, err := StartQueue(cdc, chainId, signer, checkpoints.DB())
 	if err != nil {
 		return err
 	}
 
-	//Test passhprase is correct
-	_, err = authtxb.MakeSignature(nil, validatorName, passphrase, authtxb.StdSignMsg{})
+	target := Target{
+		Provider:      provider,
+		Contract:      contractAddress,
+		Events:        eventSelection,
+		PollInterval:  pollInterval,
+		Confirmations: confirmations,
+	}
+	return Relay(queue, signer.Validator, target, checkpoints, metrics)
+}
+
+// RelayTargets relays the events of several contracts concurrently through the same queue. It only returns if
+// one of the targets cannot be relayed, with that target's error.
+func RelayTargets(queue *txs.Queue, validator sdk.AccAddress, targets []Target,
+	checkpoints *checkpoint.Store, metrics *Metrics) error {
+
+	errs := make(chan error, len(targets))
+	for _, target := range targets {
+		go func(target Target) {
+			err := Relay(queue, validator, target, checkpoints, metrics)
+			errs <- fmt.Errorf("%s: %s", target, err)
+		}(target)
+	}
+	return <-errs
+}
+
+// Relay watches the events of a target and submits the claims of the validator to the queue. It only returns if
+// the target cannot be relayed, and otherwise reconnects whenever the connection to the provider is lost.
+func Relay(queue *txs.Queue, validator sdk.AccAddress, target Target,
+	checkpoints *checkpoint.Store, metrics *Metrics) error {
+
+	contractAddress, provider := target.Contract, target.Provider
+
+	// Load Peggy Contract's ABI
+	contractABI := contract.LoadABI()
+
+	// The events to relay, keyed by the topic of their logs
+	selectedEvents, err := events.ParseEventSelection(contractABI, target.Events)
 	if err != nil {
-		fmt.Printf("passphrase error: %v", err)
 		return err
 	}
 
-	// Start client with infura ropsten provider
-	client, err := SetupWebsocketEthClient(provider)
+	// Logs are parsed with the contract's bindings, which need no backend for it
+	peggy, err := contract.NewPeggyFilterer(contractAddress, nil)
 	if err != nil {
-		fmt.Errorf("%s", err)
+		return err
+	}
+
+	// Events are claimed in the denomination the chain maps their token to. The mapping is loaded now and again
+	// whenever an event's token is missing from it
+	tokenDenoms := txs.NewTokenDenomsCache(queue.Codec())
+	if refreshErr := tokenDenoms.Refresh(); refreshErr != nil {
+		fmt.Printf("Error: bridged tokens not loaded, loading them with the first event: %s", refreshErr)
 	}
-	fmt.Printf("\nStarted ethereum websocket with provider: %s", provider)
 
-	// We need the contract address in bytes[] for the query
+	// Checkpoints and metrics are kept apart for each network
+	checkpoints = checkpoints.ForNetwork(target.Network)
+	metrics = metrics.ForTarget(target.Network, contractAddress)
+
+	// We need the contract address in bytes[] for the query, which only streams the selected events
+	topics := make([]common.Hash, 0, len(selectedEvents))
+	for topic := range selectedEvents {
+		topics = append(topics, topic)
+	}
 	query := ethereum.FilterQuery{
 		Addresses: []common.Address{contractAddress},
+		Topics:    [][]common.Hash{topics},
 	}
 
-	// We will check logs for new events
-	logs := make(chan types.Log)
+	// The last log that was fully processed. It is checkpointed whenever no claim of an earlier log is
+	// waiting in the batch, so a restarted relayer never skips a log it did not relay. Committed is the last
+	// log whose claims are all in the queue, which the relayer falls back to if the batch cannot be queued.
+	processed, resumed := checkpoints.Get(contractAddress)
+	committed := processed
+	saveCheckpoint := func() {
+		committed = processed
+		if saveErr := checkpoints.Set(contractAddress, processed); saveErr != nil {
+			fmt.Printf("Error: checkpoint not saved: %s", saveErr)
+		}
+	}
 
-	// Filter by contract and event, write results to logs
-	sub, err := client.SubscribeFilterLogs(context.Background(), query, logs)
-	if err != nil {
-		fmt.Errorf("%s", err)
-	} else {
-		fmt.Printf("\nSubscribed to contract events on address: %s\n", contractAddress.Hex())
+	// Claims are batched per ethereum block, and a batch is relayed once a log from a later block arrives
+	// or no more logs arrive for a while. If the batch cannot be queued its logs are processed again from the
+	// last committed log once the subscription restarts.
+	var batch []ethbridgetypes.EthBridgeClaim
+	var batchBlock uint64
+	relayBatch := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		relayErr := txs.RelayEvents(queue, batch)
+		batch = nil
+		if relayErr != nil {
+			processed = committed
+			return relayErr
+		}
+		saveCheckpoint()
+		return nil
+	}
+	markProcessed := func(vLog types.Log) {
+		processed = checkpoint.New(vLog.BlockNumber, vLog.Index)
+		resumed = true
+		metrics.LastProcessedBlock.Set(float64(vLog.BlockNumber))
+		if len(batch) == 0 {
+			saveCheckpoint()
+		}
 	}
 
-	// Load Peggy Contract's ABI
-	contractABI := contract.LoadABI()
+	// The handlers return an error if the log could not be handled for now and must be processed again. Logs
+	// that can never be claimed, such as unparsable ones, are reported and count as processed.
 
-	for {
-		select {
-		// Handle any errors
-		case err := <-sub.Err():
-			log.Fatal(err)
-		// vLog is raw event data
-		case vLog := <-logs:
-			// Check if the event is a 'LogLock' event
-			if vLog.Topics[0].Hex() == eventSig {
-				fmt.Printf("\n\nNew Lock Transaction:\nTx hash: %v\nBlock number: %v",
-					vLog.TxHash.Hex(), vLog.BlockNumber)
-
-				// Parse the event data into a new LockEvent using the contract's ABI
-				event := events.NewLockEvent(contractABI, "LogLock", vLog.Data)
-
-				// Add the event to the record
-				successfulStore := events.NewEventWrite(vLog.TxHash.Hex(), event)
-				if successfulStore != true {
-					fmt.Errorf("Error: event not stored")
+	// handleLock adds the claim of a lock to the batch of its block
+	handleLock := func(vLog types.Log) error {
+		fmt.Printf("\n\nNew Lock Transaction:\nTx hash: %v\nBlock number: %v",
+			vLog.TxHash.Hex(), vLog.BlockNumber)
+
+		if vLog.BlockNumber != batchBlock {
+			if relayErr := relayBatch(); relayErr != nil {
+				return relayErr
+			}
+			batchBlock = vLog.BlockNumber
+		}
+
+		// Parse the log into a new LockEvent using the contract's bindings
+		event, eventErr := events.NewLockEvent(peggy, vLog)
+		if eventErr != nil {
+			fmt.Printf("Error: %s", eventErr)
+			return nil
+		}
+
+		// Add the event to the record
+		successfulStore := events.NewEventWrite(vLog.TxHash.Hex(), event)
+		if successfulStore != true {
+			fmt.Printf("Error: event not stored")
+		}
+
+		// Parse the event's payload into a struct, in the denomination the chain maps the locked token to
+		eventDenoms, denomsErr := tokenDenoms.For(event.Token)
+		if denomsErr != nil {
+			return denomsErr
+		}
+		claim, claimErr := txs.ParsePayload(validator, vLog.TxHash, vLog.Index, &event, eventDenoms)
+		if claimErr != nil {
+			fmt.Printf("Error: %s", claimErr)
+			return nil
+		}
+		// A claim the chain would never accept is not batched, so it can't hold back the claims of its block
+		if msgErr := ethbridgetypes.NewMsgMakeEthBridgeClaim(claim).ValidateBasic(); msgErr != nil {
+			fmt.Printf("Error: %s", msgErr)
+			return nil
+		}
+
+		// Add the claim to the block's batch, relaying early if the batch is full
+		batch = append(batch, claim)
+		if len(batch) == ethbridgetypes.MaxClaimsPerBatch {
+			return relayBatch()
+		}
+		return nil
+	}
+
+	// handleRelease relays the claim of a withdrawal or unlock, which release the locked funds of an item
+	handleRelease := func(kind string, eventName string) func(types.Log) error {
+		return func(vLog types.Log) error {
+			fmt.Printf("\n\nNew %s Transaction:\nTx hash: %v\nBlock number: %v",
+				eventName, vLog.TxHash.Hex(), vLog.BlockNumber)
+
+			// Relay the claims of the block's locks first, so an item locked and withdrawn in the same
+			// block is claimed in the order it happened
+			if relayErr := relayBatch(); relayErr != nil {
+				return relayErr
+			}
+
+			event, eventErr := events.NewReleaseEvent(peggy, eventName, vLog)
+			if eventErr != nil {
+				fmt.Printf("Error: %s", eventErr)
+				return nil
+			}
+
+			eventDenoms, denomsErr := tokenDenoms.For(event.Token)
+			if denomsErr != nil {
+				return denomsErr
+			}
+
+			claim, claimErr := txs.ParseReleasePayload(validator, kind, vLog.TxHash, vLog.Index, &event, eventDenoms)
+			if claimErr != nil {
+				fmt.Printf("Error: %s", claimErr)
+				return nil
+			}
+			if msgErr := ethbridgetypes.NewMsgMakeEthBridgeReleaseClaim(claim, nil).ValidateBasic(); msgErr != nil {
+				fmt.Printf("Error: %s", msgErr)
+				return nil
+			}
+
+			return txs.RelayReleaseEvent(queue, &claim)
+		}
+	}
+
+	// handleLocking reports the contract pausing or resuming new locks, which have nothing to claim
+	handleLocking := func(eventName string) func(types.Log) error {
+		return func(vLog types.Log) error {
+			event, eventErr := events.NewLockingEvent(peggy, eventName, vLog)
+			if eventErr != nil {
+				fmt.Printf("Error: %s", eventErr)
+				return nil
+			}
+			fmt.Printf("\n\nNew %s Transaction:\nTx hash: %v\nBlock number: %v\nTime: %v\n",
+				eventName, vLog.TxHash.Hex(), vLog.BlockNumber, time.Unix(event.Time.Int64(), 0).UTC())
+			return nil
+		}
+	}
+
+	handlers := map[string]func(types.Log) error{
+		events.LogLock:             handleLock,
+		events.LogWithdraw:         handleRelease(ethbridgetypes.ReleaseKindWithdraw, events.LogWithdraw),
+		events.LogUnlock:           handleRelease(ethbridgetypes.ReleaseKindUnlock, events.LogUnlock),
+		events.LogLockingPaused:    handleLocking(events.LogLockingPaused),
+		events.LogLockingActivated: handleLocking(events.LogLockingActivated),
+	}
+
+	// handleLog only marks a log processed once it was handled, so a log that failed is processed again
+	handleLog := func(vLog types.Log) error {
+		// Logs up to the checkpoint were already relayed before a restart, or during the catch-up
+		if resumed && processed.Covers(vLog.BlockNumber, vLog.Index) {
+			return nil
+		}
+
+		// Dispatch the log to the handler of its event, if the event was selected
+		if len(vLog.Topics) > 0 {
+			if eventName, ok := selectedEvents[vLog.Topics[0]]; ok {
+				if err := handlers[eventName](vLog); err != nil {
+					return fmt.Errorf("log %d of tx %v not handled: %s", vLog.Index, vLog.TxHash.Hex(), err)
 				}
+			}
+		}
+		markProcessed(vLog)
+		return nil
+	}
+
+	// alertReorg raises and records the removal of a log the relayer already processed, whose claim may have
+	// been attested to although its lock no longer exists
+	alertReorg := func(vLog types.Log) {
+		fmt.Printf("\n\nALERT: processed log %d of tx %v in block %d was removed by a reorg\n",
+			vLog.Index, vLog.TxHash.Hex(), vLog.BlockNumber)
+		metrics.ReorgedLogs.Add(1)
+		reorg := checkpoint.NewReorg(vLog.BlockNumber, vLog.BlockHash, vLog.TxHash, vLog.Index, time.Now().UTC())
+		if recordErr := checkpoints.RecordReorg(contractAddress, reorg); recordErr != nil {
+			fmt.Printf("Error: reorg not recorded: %s", recordErr)
+		}
+	}
+
+	// subscribe connects to the provider and streams the contract's logs until the subscription fails. The
+	// subscription is opened before catching up from the checkpoint so that no log falls between the two, and
+	// connected reports whether both succeeded. Logs are buffered until they have their confirmations.
+	subscribe := func() (connected bool, err error) {
+		source, err := NewEventSource(provider, target.PollInterval)
+		if err != nil {
+			return false, err
+		}
+		defer source.Close()
+		fmt.Printf("\nConnected to ethereum provider: %s", provider)
+
+		// Filter by contract, write results to logs
+		logs := make(chan types.Log)
+		sub, err := source.SubscribeLogs(query, logs)
+		if err != nil {
+			return false, err
+		}
+		defer sub.Unsubscribe()
+		fmt.Printf("\nSubscribed to contract events on address: %s\n", contractAddress.Hex())
 
-				// Parse the event's payload into a struct
-				claim, claimErr := txs.ParsePayload(validatorAddress, &event)
-				if claimErr != nil {
-					fmt.Errorf("Error: %s", claimErr)
+		buffer := NewConfirmationBuffer(target.Confirmations)
+		defer metrics.PendingLogs.Set(0)
+		bufferLog := func(vLog types.Log) {
+			if vLog.Removed {
+				if buffer.Remove(vLog) {
+					fmt.Printf("\nLog %d of tx %v in block %d was removed by a reorg before its confirmations\n",
+						vLog.Index, vLog.TxHash.Hex(), vLog.BlockNumber)
+				} else if resumed && processed.Covers(vLog.BlockNumber, vLog.Index) {
+					alertReorg(vLog)
+				}
+			} else if !resumed || !processed.Covers(vLog.BlockNumber, vLog.Index) {
+				buffer.Add(vLog)
+			}
+			metrics.PendingLogs.Set(float64(buffer.Len()))
+		}
+		// releaseConfirmed handles the buffered logs that have their confirmations at the head block, once their
+		// block is checked to still be on the chain
+		releaseConfirmed := func(head uint64) error {
+			blockHashes := make(map[uint64]common.Hash)
+			for _, vLog := range buffer.Confirmed(head) {
+				blockHash, ok := blockHashes[vLog.BlockNumber]
+				if !ok {
+					blockHash, err = source.BlockHash(vLog.BlockNumber)
+					if err != nil {
+						return err
+					}
+					blockHashes[vLog.BlockNumber] = blockHash
+				}
+				if blockHash != vLog.BlockHash {
+					// The block was replaced by a reorg that was not notified, so its logs are fetched again and
+					// the later logs wait for them
+					fmt.Printf("\nBlock %d was replaced by a reorg, fetching its logs again\n", vLog.BlockNumber)
+					buffer.RemoveBlock(vLog.BlockNumber)
+					replaced, err := source.FilterLogs(query, vLog.BlockNumber, vLog.BlockNumber)
+					if err != nil {
+						return err
+					}
+					for _, replacedLog := range replaced {
+						buffer.Add(replacedLog)
+					}
+					break
 				}
+				buffer.Remove(vLog)
+				if err := handleLog(vLog); err != nil {
+					return err
+				}
+			}
+			metrics.PendingLogs.Set(float64(buffer.Len()))
+			return nil
+		}
 
-				// Initiate the relay
-				relayErr := txs.RelayEvent(chainId, cdc, validatorAddress, validatorName, passphrase, &claim)
-				if relayErr != nil {
-					fmt.Errorf("Error: %s", relayErr)
+		head, err := source.BlockNumber()
+		if err != nil {
+			return false, err
+		}
+		if resumed {
+			// Catch up on the logs emitted since the checkpoint while the relayer was down or disconnected
+			fmt.Printf("\nResuming from checkpoint at %s\n", processed)
+			missed, err := source.FilterLogs(query, processed.BlockNumber, head)
+			if err != nil {
+				return false, err
+			}
+			for _, vLog := range missed {
+				bufferLog(vLog)
+			}
+			if err := releaseConfirmed(head); err != nil {
+				return false, err
+			}
+			if err := relayBatch(); err != nil {
+				return false, err
+			}
+		} else {
+			// A relayer without a checkpoint starts from the live logs, and resumes from there from now on
+			processed, resumed = checkpoint.AfterBlock(head), true
+			saveCheckpoint()
+		}
+		metrics.Connected.Set(1)
+
+		for {
+			select {
+			// The subscription failed or the connection was lost
+			case err := <-sub.Err():
+				if err == nil {
+					err = fmt.Errorf("subscription closed")
+				}
+				return true, err
+			// vLog is raw event data
+			case vLog := <-logs:
+				bufferLog(vLog)
+				if vLog.BlockNumber > head {
+					head = vLog.BlockNumber
+				}
+				if err := releaseConfirmed(head); err != nil {
+					return true, err
+				}
+			case <-time.After(batchFlushDelay):
+				// Logs also get their confirmations from blocks that hold none of the contract's logs
+				if head, err = source.BlockNumber(); err != nil {
+					return true, err
+				}
+				if err := releaseConfirmed(head); err != nil {
+					return true, err
+				}
+				if err := relayBatch(); err != nil {
+					return true, err
 				}
 			}
 		}
 	}
-	return fmt.Errorf("Error: Relayer timed out.")
+
+	// Supervise the subscription, reconnecting with an exponential backoff that restarts from the minimum delay
+	// once a connection succeeds
+	delay := minReconnectDelay
+	for {
+		connected, err := subscribe()
+		metrics.Connected.Set(0)
+		// The claims of logs received before the connection was lost are still relayed
+		if relayErr := relayBatch(); relayErr != nil {
+			fmt.Printf("\nError: claims of %s not relayed, processing their logs again: %s", target, relayErr)
+		}
+
+		if connected {
+			delay = minReconnectDelay
+		}
+		fmt.Printf("\nEthereum connection of %s lost: %s. Reconnecting in %s\n", target, err, delay)
+		time.Sleep(delay)
+		metrics.Reconnects.Add(1)
+
+		delay *= 2
+		if delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+// unlockValidatorKey returns the address and name of the key relaying claims, and its passphrase once it is
+// checked to be correct
+func unlockValidatorKey(validatorFrom string) (sdk.AccAddress, string, string, error) {
+	validatorAddress, validatorName, err := sdkContext.GetFromFields(validatorFrom, false)
+	if err != nil {
+		fmt.Printf("failed to get from fields: %v", err)
+		return nil, "", "", err
+	}
+
+	passphrase, err := keys.GetPassphrase(validatorFrom)
+	if err != nil {
+		return nil, "", "", err
+	}
+
+	//Test passhprase is correct
+	_, err = authtxb.MakeSignature(nil, validatorName, passphrase, authtxb.StdSignMsg{})
+	if err != nil {
+		fmt.Printf("passphrase error: %v", err)
+		return nil, "", "", err
+	}
+
+	return validatorAddress, validatorName, passphrase, nil
 }
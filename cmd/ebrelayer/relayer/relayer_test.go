@@ -13,9 +13,13 @@ import (
 	"fmt"
 	"strings"
 	"encoding/hex"
+	"io/ioutil"
+	"os"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/cli"
 	app "github.com/pumpkinzomb/cosmos-ethereum-bridge"
 )
 
@@ -23,13 +27,19 @@ const (
 	ChainID          = "testing"
 	Socket           = "wss://ropsten.infura.io/ws"
 	ContractAddress  =  "3de4ef81Ba6243A60B0a32d3BCeD4173b6EA02bb"
-	EventSig         = "0xe154a56f2d306d5bbe4ac2379cb0cfc906b23685047a2bd2f5f0a0e810888f72"
+	EventSig         = "LogLock(bytes32,address,bytes,address,uint256,uint256)"
 	Validator        = "validator"
 )
 
 func TestInitRelayer(t *testing.T) {
 	cdc := app.MakeCodec()
 
+	// Keep the keybase out of the source tree
+	home, err := ioutil.TempDir("", "ebrelayer")
+	require.NoError(t, err)
+	defer os.RemoveAll(home)
+	viper.Set(cli.HomeFlag, home)
+
 	// Parse the address of the deployed contract
 	bytesContractAddress, err := hex.DecodeString(ContractAddress)
 	if err != nil {
@@ -37,7 +47,7 @@ func TestInitRelayer(t *testing.T) {
 	}
 	contractAddress := common.BytesToAddress(bytesContractAddress)
 
-	err = InitRelayer(cdc, ChainID, Socket, contractAddress, EventSig, Validator)
+	err = InitRelayer(cdc, ChainID, Socket, contractAddress, EventSig, Validator, nil, nil, nil, DefaultPollInterval, DefaultConfirmations)
 
 	//TODO: add validator key processing for relayer init
 	require.Error(t, err)
@@ -0,0 +1,152 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Source
+//
+//    Event sources stream the logs of a contract from an
+//    Ethereum provider, either through a websocket
+//    subscription or by polling eth_getLogs over HTTP.
+// ------------------------------------------------------------
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// DefaultPollInterval is how often a polling source asks an HTTP provider for new logs
+const DefaultPollInterval = 5 * time.Second
+
+// EventSource is a connection to an ethereum provider that streams and fetches contract logs
+type EventSource interface {
+	// BlockNumber returns the latest block of the provider
+	BlockNumber() (uint64, error)
+	// BlockHash returns the hash of the canonical block at a height
+	BlockHash(blockNumber uint64) (common.Hash, error)
+	// FilterLogs returns the logs matching the query from fromBlock to toBlock inclusive, in chain order
+	FilterLogs(query ethereum.FilterQuery, fromBlock uint64, toBlock uint64) ([]types.Log, error)
+	// SubscribeLogs streams the logs matching the query from the blocks after the latest one
+	SubscribeLogs(query ethereum.FilterQuery, logs chan<- types.Log) (ethereum.Subscription, error)
+	// Close closes the connection
+	Close()
+}
+
+// NewEventSource connects to a websocket provider with a subscription, or to an HTTP provider that is polled
+// for new logs every pollInterval
+func NewEventSource(provider string, pollInterval time.Duration) (EventSource, error) {
+	if IsWebsocketURL(provider) {
+		client, err := SetupWebsocketEthClient(provider)
+		if err != nil {
+			return nil, err
+		}
+		return NewWebsocketSource(client), nil
+	}
+	if IsHTTPURL(provider) {
+		client, err := SetupHTTPEthClient(provider)
+		if err != nil {
+			return nil, err
+		}
+		return NewPollingSource(client, pollInterval), nil
+	}
+	return nil, fmt.Errorf("unsupported eth client URL: %v", provider)
+}
+
+// clientSource fetches blocks and logs through an ethclient
+type clientSource struct {
+	client *ethclient.Client
+}
+
+func (source clientSource) BlockNumber() (uint64, error) {
+	return source.client.BlockNumber(context.Background())
+}
+
+func (source clientSource) BlockHash(blockNumber uint64) (common.Hash, error) {
+	header, err := source.client.HeaderByNumber(context.Background(), new(big.Int).SetUint64(blockNumber))
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return header.Hash(), nil
+}
+
+func (source clientSource) FilterLogs(query ethereum.FilterQuery, fromBlock uint64, toBlock uint64) ([]types.Log, error) {
+	return FilterLogs(source.client, query, fromBlock, toBlock)
+}
+
+func (source clientSource) Close() {
+	source.client.Close()
+}
+
+// WebsocketSource streams logs through an eth_subscribe subscription
+type WebsocketSource struct {
+	clientSource
+}
+
+// NewWebsocketSource returns a source streaming logs from a websocket client
+func NewWebsocketSource(client *ethclient.Client) *WebsocketSource {
+	return &WebsocketSource{clientSource{client: client}}
+}
+
+// SubscribeLogs subscribes to the logs of the query
+func (source *WebsocketSource) SubscribeLogs(query ethereum.FilterQuery, logs chan<- types.Log) (ethereum.Subscription, error) {
+	return source.client.SubscribeFilterLogs(context.Background(), query, logs)
+}
+
+// PollingSource streams logs by repeatedly calling eth_getLogs over the blocks mined since its last call
+type PollingSource struct {
+	clientSource
+	interval time.Duration
+}
+
+// NewPollingSource returns a source polling a client for new logs every interval
+func NewPollingSource(client *ethclient.Client, interval time.Duration) *PollingSource {
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	return &PollingSource{clientSource: clientSource{client: client}, interval: interval}
+}
+
+// SubscribeLogs polls for the logs of the query in the blocks after the latest one. The subscription fails with
+// the first error of the provider.
+func (source *PollingSource) SubscribeLogs(query ethereum.FilterQuery, logs chan<- types.Log) (ethereum.Subscription, error) {
+	from, err := source.BlockNumber()
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		ticker := time.NewTicker(source.interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-quit:
+				return nil
+			case <-ticker.C:
+				head, err := source.BlockNumber()
+				if err != nil {
+					return err
+				}
+				if head <= from {
+					continue
+				}
+				newLogs, err := source.FilterLogs(query, from+1, head)
+				if err != nil {
+					return err
+				}
+				for _, vLog := range newLogs {
+					select {
+					case logs <- vLog:
+					case <-quit:
+						return nil
+					}
+				}
+				from = head
+			}
+		}
+	}), nil
+}
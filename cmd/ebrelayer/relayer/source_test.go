@@ -0,0 +1,106 @@
+package relayer
+
+// ------------------------------------------------------------
+//    Source_test
+//
+//    Tests source.go functionality against an in-process
+//    ethereum JSON-RPC server.
+//
+// ------------------------------------------------------------
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/require"
+)
+
+// testEthService serves a chain whose every block holds a single log
+type testEthService struct {
+	mu   sync.Mutex
+	head uint64
+}
+
+func (service *testEthService) BlockNumber() hexutil.Uint64 {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	return hexutil.Uint64(service.head)
+}
+
+func (service *testEthService) GetLogs(ctx context.Context, args map[string]interface{}) ([]types.Log, error) {
+	from, err := hexutil.DecodeUint64(args["fromBlock"].(string))
+	if err != nil {
+		return nil, err
+	}
+	to, err := hexutil.DecodeUint64(args["toBlock"].(string))
+	if err != nil {
+		return nil, err
+	}
+	logs := []types.Log{}
+	for block := from; block <= to; block++ {
+		logs = append(logs, types.Log{BlockNumber: block, Topics: []common.Hash{}, Data: []byte{}})
+	}
+	return logs, nil
+}
+
+func (service *testEthService) mine(blocks uint64) {
+	service.mu.Lock()
+	defer service.mu.Unlock()
+	service.head += blocks
+}
+
+func TestIsHTTPURL(t *testing.T) {
+	require.True(t, IsHTTPURL("http://localhost:8545"))
+	require.True(t, IsHTTPURL("https://ropsten.infura.io"))
+	require.False(t, IsHTTPURL(Client))
+}
+
+func TestNewEventSourceUnsupportedURL(t *testing.T) {
+	_, err := NewEventSource("ipc:///tmp/geth.ipc", DefaultPollInterval)
+	require.Error(t, err)
+}
+
+func TestPollingSource(t *testing.T) {
+	service := &testEthService{head: 10}
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", service))
+	defer server.Stop()
+
+	source := NewPollingSource(ethclient.NewClient(rpc.DialInProc(server)), 10*time.Millisecond)
+	defer source.Close()
+
+	logs := make(chan types.Log)
+	sub, err := source.SubscribeLogs(ethereum.FilterQuery{}, logs)
+	require.NoError(t, err)
+	defer sub.Unsubscribe()
+
+	// Only the logs of the blocks mined after subscribing are streamed, in order
+	service.mine(3)
+	for block := uint64(11); block <= 13; block++ {
+		select {
+		case vLog := <-logs:
+			require.Equal(t, block, vLog.BlockNumber)
+		case err := <-sub.Err():
+			t.Fatal(err)
+		case <-time.After(time.Second):
+			t.Fatalf("log of block %d not polled", block)
+		}
+	}
+
+	// Blocks already polled are not requested again
+	service.mine(1)
+	select {
+	case vLog := <-logs:
+		require.Equal(t, uint64(14), vLog.BlockNumber)
+	case <-time.After(time.Second):
+		t.Fatal("log of block 14 not polled")
+	}
+}
@@ -10,46 +10,97 @@ package txs
 // --------------------------------------------------------
 
 import (
+  "math/big"
   "strings"
   "strconv"
   "fmt"
 
   sdk "github.com/cosmos/cosmos-sdk/types"
+  "github.com/ethereum/go-ethereum/common"
+  "github.com/ethereum/go-ethereum/common/hexutil"
   "github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/events"
   "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
 )
 
-func ParsePayload(validator sdk.AccAddress, event *events.LockEvent) (types.EthBridgeClaim, error) {
+func ParsePayload(validator sdk.AccAddress, txHash common.Hash, logIndex uint, event *events.LockEvent, tokenDenoms types.TokenDenoms) (types.EthBridgeClaim, error) {
   
   witnessClaim := types.EthBridgeClaim{}
 
+  // ItemID type casting ([32]byte -> string)
+  witnessClaim.ItemID = hexutil.Encode(event.Id[:])
+
+  // Evidence of where the event was emitted on Ethereum
+  witnessClaim.EthereumTxHash = txHash.Hex()
+  witnessClaim.EthereumLogIndex = uint64(logIndex)
+
   // Nonce type casting (*big.Int -> int)
   nonce, nonceErr := strconv.Atoi(event.Nonce.String())
   if nonceErr != nil {
-    fmt.Errorf("%s", nonceErr)
+    return types.EthBridgeClaim{}, nonceErr
   }
   witnessClaim.Nonce = nonce
 
   // EthereumSender type casting (address.common -> string)
   witnessClaim.EthereumSender = event.From.Hex()
 
-  // CosmosReceiver type casting (bytes[] -> sdk.AccAddress)
-  recipient, recipientErr := sdk.AccAddressFromBech32(string(event.To[:]))
+  // CosmosReceiver and post-mint actions (bytes[] -> sdk.AccAddress, memo). A lock whose recipient cannot
+  // be parsed is not claimed, the sender can withdraw it on Peggy
+  recipient, postMintActions, recipientErr := types.ParseRecipient(event.To)
   if recipientErr != nil {
-    fmt.Errorf("%s", recipientErr)
+    return types.EthBridgeClaim{}, fmt.Errorf("invalid recipient %s: %s", string(event.To), recipientErr)
   }
   witnessClaim.CosmosReceiver = recipient
+  witnessClaim.PostMintActions = postMintActions
 
   // Validator is already the correct type (sdk.AccAddress)
   witnessClaim.Validator = validator
 
-  // Amount type casting (*big.Int -> sdk.Coins)
-  ethereumCoin := []string {event.Value.String(),"ethereum"}
-  weiAmount, coinErr := sdk.ParseCoins(strings.Join(ethereumCoin, ""))
+  // Amount type casting (*big.Int, token -> sdk.Coins). Locks of tokens that are not bridged are not claimed
+  weiAmount, coinErr := parseAmount(event.Value, event.Token, tokenDenoms)
   if coinErr != nil {
-    fmt.Errorf("%s", coinErr)
+    return types.EthBridgeClaim{}, coinErr
   }
   witnessClaim.Amount = weiAmount
 
   return witnessClaim, nil
 }
+
+func ParseReleasePayload(validator sdk.AccAddress, kind string, txHash common.Hash, logIndex uint, event *events.ReleaseEvent, tokenDenoms types.TokenDenoms) (types.EthBridgeReleaseClaim, error) {
+
+  releaseClaim := types.EthBridgeReleaseClaim{}
+
+  // ItemID type casting ([32]byte -> string)
+  releaseClaim.ItemID = hexutil.Encode(event.Id[:])
+
+  // Evidence of where the event was emitted on Ethereum
+  releaseClaim.EthereumTxHash = txHash.Hex()
+  releaseClaim.EthereumLogIndex = uint64(logIndex)
+
+  // Withdrawn by the original sender, or unlocked by the peggy relayer
+  releaseClaim.Kind = kind
+
+  // EthereumReceiver type casting (address.common -> string)
+  releaseClaim.EthereumReceiver = event.To.Hex()
+
+  // Validator is already the correct type (sdk.AccAddress)
+  releaseClaim.Validator = validator
+
+  // Amount type casting (*big.Int, token -> sdk.Coins)
+  weiAmount, coinErr := parseAmount(event.Value, event.Token, tokenDenoms)
+  if coinErr != nil {
+    return types.EthBridgeReleaseClaim{}, coinErr
+  }
+  releaseClaim.Amount = weiAmount
+
+  return releaseClaim, nil
+}
+
+// parseAmount returns the coins of a value of a token, in the denomination the chain maps the token to
+func parseAmount(value *big.Int, token common.Address, tokenDenoms types.TokenDenoms) (sdk.Coins, error) {
+  denom, ok := tokenDenoms.DenomOf(token)
+  if !ok {
+    return sdk.Coins{}, fmt.Errorf("token %s is not bridged", token.Hex())
+  }
+  coin := []string {value.String(), denom}
+  return sdk.ParseCoins(strings.Join(coin, ""))
+}
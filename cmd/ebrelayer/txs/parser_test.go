@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/cmd/ebrelayer/events"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
 	"github.com/ethereum/go-ethereum/common"
 )
 
@@ -19,19 +20,19 @@ func init() {
 	// Set up testing parameters for the parser
 	testValidator, err := sdk.AccAddressFromBech32("cosmos1xdp5tvt7lxh8rf9xx07wy2xlagzhq24ha48xtq")
   if err != nil {
-    fmt.Errorf("%s", err)
+    panic(err)
   }
   TestValidator = testValidator
 
 	// Mock expected data from the parser
-	TestEventData := events.LockEvent{}
+	TestEventData = events.LockEvent{}
 
 	var arr [32]byte
 	copy(arr[:], []byte("0xab85e2ceaa7d100af2f07cac01365f3777153a4e004342dca5db44e731b9d461"))
 	TestEventData.Id = arr
 	TestEventData.From = common.BytesToAddress([]byte("0xC8Ee928625908D90d4B60859052aD200CBe2792A"))
-	TestEventData.To = []byte("0x6e656f")
-	TestEventData.Token = common.BytesToAddress([]byte("0x0000000000000000000000000000000000000000"))
+	TestEventData.To = []byte(testValidator.String())
+	TestEventData.Token = common.HexToAddress("0x0000000000000000000000000000000000000000")
 
 	value := new(big.Int)
 	value, okValue := value.SetString("7", 10)
@@ -53,16 +54,62 @@ func init() {
 
 // Set up data for parameters and to compare against
 func TestParsePayload(t *testing.T) {
-	result, err := ParsePayload(TestValidator, &TestEventData)
+	txHash := common.HexToHash("0x6bc6fa7d1e5bcd1f0c5b4ac2f8bfd3c8c4bb0aa2fb3e2b1d7b4e8c4a9d3f1e20")
+	result, err := ParsePayload(TestValidator, txHash, 3, &TestEventData, types.TokenDenoms{})
 
 	require.NoError(t, err)
 	fmt.Printf("%+v", result)
+	require.Equal(t, txHash.Hex(), result.EthereumTxHash)
+	require.Equal(t, uint64(3), result.EthereumLogIndex)
+	require.Equal(t, 39, result.Nonce)
+	require.Equal(t, TestValidator, result.CosmosReceiver)
+	require.Equal(t, TestValidator, result.Validator)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("ethereum", 7)), result.Amount)
+}
+
+func TestParsePayloadInvalidNonce(t *testing.T) {
+	event := TestEventData
+	event.Nonce = nil
+	_, err := ParsePayload(TestValidator, common.Hash{}, 0, &event, types.TokenDenoms{})
+	require.Error(t, err)
+
+}
+
+func TestParsePayloadToken(t *testing.T) {
+	token := common.HexToAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+	event := TestEventData
+	event.Token = token
+
+	// Locks of tokens that are not bridged are not claimed
+	_, err := ParsePayload(TestValidator, common.Hash{}, 0, &event, types.TokenDenoms{})
+	require.Error(t, err)
 
-	// TODO: check each individual argument
-	// require.Equal(t, "7", string(result.Nonce))
-	// require.Equal(t, common.BytesToAddress([]byte("0xC8Ee928625908D90d4B60859052aD200CBe2792A")), result.EthereumSender)
-	// require.Equal(t, result.CosmosReceiver, "neo")
-	// require.Equal(t, result.Validator, TestValidator)
-	// require.Equal(t, result.Amount, 7)
+	tokenDenoms := types.TokenDenoms{types.NewTokenDenom(token.Hex(), "dai")}
+	result, err := ParsePayload(TestValidator, common.Hash{}, 0, &event, tokenDenoms)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("dai", 7)), result.Amount)
+}
+func TestParsePayloadWithMemo(t *testing.T) {
+	receiver, err := sdk.AccAddressFromBech32("cosmos1gn8409qq9hnrxde37kuxwx5hrxpfpv8426szuv")
+	require.NoError(t, err)
+	actions := types.PostMintActions{
+		types.NewPostMintAction(types.PostMintActionSend, sdk.AccAddress(TestValidator), nil, nil),
+	}
 
-}
\ No newline at end of file
+	event := events.LockEvent{
+		From:  common.HexToAddress("0xC8Ee928625908D90d4B60859052aD200CBe2792A"),
+		To:    types.FormatRecipient(receiver, actions),
+		Value: big.NewInt(7),
+		Nonce: big.NewInt(39),
+	}
+	txHash := common.HexToHash("0x6bc6fa7d1e5bcd1f0c5b4ac2f8bfd3c8c4bb0aa2fb3e2b1d7b4e8c4a9d3f1e20")
+	result, err := ParsePayload(TestValidator, txHash, 3, &event, types.TokenDenoms{})
+	require.NoError(t, err)
+	require.Equal(t, receiver, result.CosmosReceiver)
+	require.True(t, actions.Equal(result.PostMintActions))
+
+	// Memos with unknown actions are not claimed
+	event.To = []byte(receiver.String() + `|{"actions":[{"type":"swap"}]}`)
+	_, err = ParsePayload(TestValidator, txHash, 3, &event, types.TokenDenoms{})
+	require.Error(t, err)
+}
@@ -0,0 +1,169 @@
+package txs
+
+// ------------------------------------------------------------
+//      Query
+//
+//      Looks up the prophecies validators already claimed on
+//      the Cosmos Bridge, so that claims are not relayed twice.
+// ------------------------------------------------------------
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	amino "github.com/tendermint/go-amino"
+
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+	oracletypes "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/types"
+)
+
+// Reasons for not relaying a claim that the chain would reject
+const (
+	SkipAlreadyClaimed = "the validator already claimed it"
+	SkipFinalized      = "its prophecy is already finalized"
+)
+
+// queryError is the log of a failed query
+type queryError struct {
+	Codespace sdk.CodespaceType `json:"codespace"`
+	Code      sdk.CodeType      `json:"code"`
+}
+
+// QueryProphecy returns the prophecy of a peggy item, or false if no validator has claimed the item yet
+func QueryProphecy(cdc *amino.Codec, itemID string) (types.QueryEthProphecyResponse, bool, error) {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	bz, err := cdc.MarshalJSON(types.NewQueryEthProphecyParams(itemID))
+	if err != nil {
+		return types.QueryEthProphecyResponse{}, false, err
+	}
+
+	route := fmt.Sprintf("custom/%s/%s", ethbridge.QuerierRoute, ethbridge.QueryEthProphecy)
+	res, err := cliCtx.QueryWithData(route, bz)
+	if err != nil {
+		// The ethbridge querier reports missing prophecies with the oracle code under its own codespace
+		var queryErr queryError
+		if json.Unmarshal([]byte(err.Error()), &queryErr) == nil && queryErr.Codespace == ethbridge.DefaultCodespace &&
+			queryErr.Code == oracletypes.CodeProphecyNotFound {
+			return types.QueryEthProphecyResponse{}, false, nil
+		}
+		return types.QueryEthProphecyResponse{}, false, err
+	}
+
+	var prophecy types.QueryEthProphecyResponse
+	if err := cdc.UnmarshalJSON(res, &prophecy); err != nil {
+		return types.QueryEthProphecyResponse{}, false, err
+	}
+	return prophecy, true, nil
+}
+
+// HasClaimed returns whether a validator made a claim on a prophecy
+func HasClaimed(prophecy types.QueryEthProphecyResponse, validator sdk.AccAddress) bool {
+	for _, claim := range prophecy.EthBridgeClaims {
+		if claim.Validator.Equals(validator) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClaimSkipReason returns why a validator's claim on a prophecy would be rejected as a duplicate or as too late,
+// or an empty reason if the claim is still needed
+func ClaimSkipReason(prophecy types.QueryEthProphecyResponse, found bool, validator sdk.AccAddress) string {
+	if !found {
+		return ""
+	}
+	if HasClaimed(prophecy, validator) {
+		return SkipAlreadyClaimed
+	}
+	if prophecy.Status.StatusText != oracletypes.PendingStatusText {
+		return SkipFinalized
+	}
+	return ""
+}
+
+// PreflightClaim queries the prophecy of a claim and returns why it need not be relayed, if so
+func PreflightClaim(cdc *amino.Codec, claim types.EthBridgeClaim) (string, error) {
+	prophecy, found, err := QueryProphecy(cdc, claim.ItemID)
+	if err != nil {
+		return "", err
+	}
+	return ClaimSkipReason(prophecy, found, claim.Validator), nil
+}
+
+// QueryParams returns the params of the ethbridge module
+func QueryParams(cdc *amino.Codec) (types.Params, error) {
+	cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+	route := fmt.Sprintf("custom/%s/%s", ethbridge.QuerierRoute, ethbridge.QueryParams)
+	res, err := cliCtx.QueryWithData(route, nil)
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	var params types.Params
+	if err := cdc.UnmarshalJSON(res, &params); err != nil {
+		return types.Params{}, err
+	}
+	return params, nil
+}
+
+// TokenDenomsCache keeps the bridged ERC20 tokens of the chain's params, so that events are not parsed with a
+// query each
+type TokenDenomsCache struct {
+	query func() (types.TokenDenoms, error)
+
+	mtx         sync.Mutex
+	tokenDenoms types.TokenDenoms
+	loaded      bool
+}
+
+// NewTokenDenomsCache returns a cache of the bridged tokens of the chain, which is loaded when first needed
+func NewTokenDenomsCache(cdc *amino.Codec) *TokenDenomsCache {
+	return newTokenDenomsCache(func() (types.TokenDenoms, error) {
+		params, err := QueryParams(cdc)
+		return params.TokenDenoms, err
+	})
+}
+
+func newTokenDenomsCache(query func() (types.TokenDenoms, error)) *TokenDenomsCache {
+	return &TokenDenomsCache{query: query}
+}
+
+// Refresh loads the bridged tokens from the chain
+func (c *TokenDenomsCache) Refresh() error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.refresh()
+}
+
+func (c *TokenDenomsCache) refresh() error {
+	tokenDenoms, err := c.query()
+	if err != nil {
+		return err
+	}
+	c.tokenDenoms, c.loaded = tokenDenoms, true
+	return nil
+}
+
+// For returns the bridged tokens to parse an event of a token with. The cached tokens are reloaded if they don't
+// map the token, so a token the admin just added is not taken for one that is not bridged. An error means the
+// chain could not be queried and the event should be parsed again later.
+func (c *TokenDenomsCache) For(token gethCommon.Address) (types.TokenDenoms, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.loaded {
+		if _, ok := c.tokenDenoms.DenomOf(token); ok {
+			return c.tokenDenoms, nil
+		}
+	}
+	if err := c.refresh(); err != nil {
+		return nil, err
+	}
+	return c.tokenDenoms, nil
+}
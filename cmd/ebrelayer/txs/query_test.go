@@ -0,0 +1,76 @@
+package txs
+
+import (
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+	oracletypes "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/types"
+)
+
+func TestHasClaimed(t *testing.T) {
+	other := sdk.AccAddress([]byte("other_validator_addr"))
+	claim := types.EthBridgeClaim{ItemID: "0x01", Validator: TestValidator}
+	prophecy := types.QueryEthProphecyResponse{EthBridgeClaims: []types.EthBridgeClaim{claim}}
+
+	require.True(t, HasClaimed(prophecy, TestValidator))
+	require.False(t, HasClaimed(prophecy, other))
+	require.False(t, HasClaimed(types.QueryEthProphecyResponse{}, TestValidator))
+}
+
+func TestClaimSkipReason(t *testing.T) {
+	other := sdk.AccAddress([]byte("other_validator_addr"))
+	claim := types.EthBridgeClaim{ItemID: "0x01", Validator: TestValidator}
+	pending := types.QueryEthProphecyResponse{
+		Status:          oracletypes.NewStatus(oracletypes.PendingStatusText, ""),
+		EthBridgeClaims: []types.EthBridgeClaim{claim},
+	}
+	successful := types.QueryEthProphecyResponse{
+		Status:          oracletypes.NewStatus(oracletypes.SuccessStatusText, ""),
+		EthBridgeClaims: []types.EthBridgeClaim{claim},
+	}
+
+	require.Equal(t, "", ClaimSkipReason(types.QueryEthProphecyResponse{}, false, TestValidator))
+	require.Equal(t, SkipAlreadyClaimed, ClaimSkipReason(pending, true, TestValidator))
+	require.Equal(t, "", ClaimSkipReason(pending, true, other))
+	require.Equal(t, SkipAlreadyClaimed, ClaimSkipReason(successful, true, TestValidator))
+	require.Equal(t, SkipFinalized, ClaimSkipReason(successful, true, other))
+}
+
+func TestTokenDenomsCache(t *testing.T) {
+	dai := gethCommon.HexToAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+	mkr := gethCommon.HexToAddress("0x9f8f72aa9304c8b593d555f12ef6589cc3a579a2")
+	chain := types.TokenDenoms{types.NewTokenDenom(dai.Hex(), "dai")}
+	var queryErr error
+	queries := 0
+	cache := newTokenDenomsCache(func() (types.TokenDenoms, error) {
+		queries++
+		return chain, queryErr
+	})
+
+	//The tokens are loaded once, and kept while they map the tokens of the events
+	require.NoError(t, cache.Refresh())
+	for _, token := range []gethCommon.Address{dai, {}} {
+		tokenDenoms, err := cache.For(token)
+		require.NoError(t, err)
+		require.Equal(t, chain, tokenDenoms)
+	}
+	require.Equal(t, 1, queries)
+
+	//A token that is not mapped reloads them, and a failed query is reported instead of the token being skipped
+	queryErr = errors.New("connection refused")
+	_, err := cache.For(mkr)
+	require.Error(t, err)
+	queryErr = nil
+	chain = append(chain, types.NewTokenDenom(mkr.Hex(), "mkr"))
+	tokenDenoms, err := cache.For(mkr)
+	require.NoError(t, err)
+	denom, ok := tokenDenoms.DenomOf(mkr)
+	require.True(t, ok)
+	require.Equal(t, "mkr", denom)
+	require.Equal(t, 3, queries)
+}
@@ -0,0 +1,443 @@
+package txs
+
+// ------------------------------------------------------------
+//      Queue
+//
+//      Submits the Msgs of the relayer one transaction at a
+//      time, tracking the account sequence locally so that
+//      claims relayed in quick succession do not race on it.
+//      Broadcasts are retried with a backoff, their inclusion
+//      is confirmed by polling the tx hash, and unconfirmed
+//      submissions are persisted across restarts.
+// ------------------------------------------------------------
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+	amino "github.com/tendermint/go-amino"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+const (
+	// queuePollInterval is how often the queue polls the inclusion of its transactions and retries its broadcasts
+	queuePollInterval = 2 * time.Second
+
+	// InclusionTimeout is how long a broadcast transaction may wait for a block before it is considered dropped
+	// from the mempool, and its submission broadcast again
+	InclusionTimeout = 2 * time.Minute
+
+	// minRetryDelay and maxRetryDelay bound the exponential backoff between the broadcasts of a submission
+	minRetryDelay = 1 * time.Second
+	maxRetryDelay = 1 * time.Minute
+)
+
+var submissionPrefix = []byte("submission/")
+
+// Submission is a Msg waiting in the queue until its transaction is included in a block
+type Submission struct {
+	ID  uint64  `json:"id"`
+	Msg sdk.Msg `json:"msg"`
+	// TxHash, Sequence and BroadcastAt describe the last broadcast of the Msg, and TxHash is empty while the
+	// submission waits to be broadcast
+	TxHash      string    `json:"tx_hash"`
+	Sequence    uint64    `json:"sequence"`
+	BroadcastAt time.Time `json:"broadcast_at"`
+	// Attempts counts the failed broadcasts since the last successful one
+	Attempts uint64 `json:"attempts"`
+
+	retryAt time.Time
+}
+
+// broadcastOutcome is what the queue does with a submission after broadcasting it
+type broadcastOutcome int
+
+const (
+	// broadcastAccepted waits for the transaction to be included
+	broadcastAccepted broadcastOutcome = iota
+	// broadcastRetry broadcasts the transaction again after a backoff, eg. when the mempool is full
+	broadcastRetry
+	// broadcastResync fetches the account sequence from the chain before broadcasting again
+	broadcastResync
+	// broadcastRejected drops the submission, which the chain will never accept
+	broadcastRejected
+)
+
+// classifyBroadcast returns the outcome of a synchronous broadcast from the node's response
+func classifyBroadcast(res sdk.TxResponse, err error) broadcastOutcome {
+	if err != nil {
+		// The same transaction is still in the mempool from an earlier broadcast
+		if strings.Contains(err.Error(), "already exists in cache") {
+			return broadcastAccepted
+		}
+		// The mempool is full, or the node could not be reached
+		return broadcastRetry
+	}
+	switch {
+	case res.Code == uint32(sdk.CodeOK):
+		return broadcastAccepted
+	case res.Code == uint32(sdk.CodeInvalidSequence),
+		res.Code == uint32(sdk.CodeUnauthorized) && strings.Contains(res.RawLog, "sequence"):
+		// The sequence is part of the signed bytes, so a sequence mismatch fails the signature verification
+		return broadcastResync
+	default:
+		return broadcastRejected
+	}
+}
+
+// isTxNotFound returns whether a tx query failed because the node does not know the transaction, rather than
+// because the node could not be reached or could not look it up
+func isTxNotFound(err error) bool {
+	return strings.Contains(err.Error(), "not found")
+}
+
+// retryDelay returns the backoff before the next broadcast of a submission that failed a number of times
+func retryDelay(attempts uint64) time.Duration {
+	delay := minRetryDelay
+	for i := uint64(1); i < attempts && delay < maxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxRetryDelay {
+		delay = maxRetryDelay
+	}
+	return delay
+}
+
+// Queue submits the Msgs of a signer in order. It is safe to submit from several goroutines.
+type Queue struct {
+	cdc        *amino.Codec
+	address    sdk.AccAddress
+	name       string
+	passphrase string
+	db         dbm.DB
+
+	cliCtx context.CLIContext
+	txBldr authtxb.TxBuilder
+
+	mtx      sync.Mutex
+	nextID   uint64
+	pending  int
+	incoming chan *Submission
+
+	// The submissions and account are only used by Run
+	submissions   []*Submission
+	synced        bool
+	accountNumber uint64
+	sequence      uint64
+
+	// queryTx looks up broadcast transactions, and confirmAt delays the next lookup after queryFailures failed
+	queryTx       func(txHash string) (*ctypes.ResultTx, error)
+	queryFailures uint64
+	confirmAt     time.Time
+}
+
+// NewQueue returns the queue of a signer, which picks up the unconfirmed submissions persisted in the database
+func NewQueue(cdc *amino.Codec, chainId string, validatorAddress sdk.AccAddress, validatorName string,
+	passphrase string, db dbm.DB) (*Queue, error) {
+
+	submissions, err := loadSubmissions(cdc, db)
+	if err != nil {
+		return nil, err
+	}
+
+	cliCtx := context.NewCLIContext().
+		WithCodec(cdc).
+		WithAccountDecoder(cdc).
+		WithFromAddress(validatorAddress).
+		WithFromName(validatorName)
+
+	txBldr := authtxb.NewTxBuilderFromCLI().
+		WithTxEncoder(utils.GetTxEncoder(cdc)).
+		WithChainID(chainId)
+
+	queue := &Queue{
+		cdc:         cdc,
+		address:     validatorAddress,
+		name:        validatorName,
+		passphrase:  passphrase,
+		db:          db,
+		cliCtx:      cliCtx,
+		txBldr:      txBldr,
+		pending:     len(submissions),
+		incoming:    make(chan *Submission, 256),
+		submissions: submissions,
+	}
+	queue.queryTx = queue.queryNodeTx
+	if len(submissions) > 0 {
+		queue.nextID = submissions[len(submissions)-1].ID + 1
+		fmt.Printf("\nResuming %d unconfirmed submissions\n", len(submissions))
+	}
+	return queue, nil
+}
+
+// Address returns the address of the queue's signer
+func (q *Queue) Address() sdk.AccAddress {
+	return q.address
+}
+
+// Codec returns the codec of the queue
+func (q *Queue) Codec() *amino.Codec {
+	return q.cdc
+}
+
+// Pending returns the number of submissions that are not confirmed yet
+func (q *Queue) Pending() int {
+	q.mtx.Lock()
+	defer q.mtx.Unlock()
+	return q.pending
+}
+
+// Submit durably adds a Msg to the queue, which broadcasts it once the earlier submissions were broadcast
+func (q *Queue) Submit(msg sdk.Msg) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return fmt.Errorf("msg validation error: %s", err)
+	}
+
+	q.mtx.Lock()
+	sub := &Submission{ID: q.nextID, Msg: msg}
+	if err := saveSubmission(q.cdc, q.db, sub); err != nil {
+		q.mtx.Unlock()
+		return err
+	}
+	q.nextID++
+	q.pending++
+	q.mtx.Unlock()
+
+	q.incoming <- sub
+	return nil
+}
+
+// Run broadcasts the submissions and confirms their inclusion. It never returns.
+func (q *Queue) Run() {
+	ticker := time.NewTicker(queuePollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case sub := <-q.incoming:
+			q.submissions = append(q.submissions, sub)
+		case <-ticker.C:
+			q.confirm(time.Now())
+		}
+		q.broadcast(time.Now())
+	}
+}
+
+// Drain waits until every submission is confirmed or dropped
+func (q *Queue) Drain() {
+	for q.Pending() > 0 {
+		time.Sleep(queuePollInterval)
+	}
+}
+
+// confirm removes the submissions whose transaction was included in a block. Transactions the node does not know
+// of after the timeout were dropped from the mempool, along with the later ones of the account that could not be
+// included before them, so these are broadcast again with the sequence of the chain. When the node can't be
+// queried nothing is known about the transactions, so the lookups are retried after a backoff instead.
+func (q *Queue) confirm(now time.Time) {
+	if now.Before(q.confirmAt) {
+		return
+	}
+	var dropped *Submission
+	var included []*Submission
+	var queryErr error
+	for _, sub := range q.submissions {
+		if sub.TxHash == "" {
+			continue
+		}
+		res, err := q.queryTx(sub.TxHash)
+		if err != nil && !isTxNotFound(err) {
+			queryErr = err
+			break
+		}
+		if err != nil {
+			if now.Sub(sub.BroadcastAt) > InclusionTimeout && (dropped == nil || sub.Sequence < dropped.Sequence) {
+				dropped = sub
+			}
+			continue
+		}
+		if res.TxResult.IsOK() {
+			fmt.Printf("\nSubmission %d included in block %d: tx %s\n", sub.ID, res.Height, sub.TxHash)
+		} else {
+			fmt.Printf("\nSubmission %d included in block %d but failed: %s\n", sub.ID, res.Height, res.TxResult.Log)
+		}
+		included = append(included, sub)
+	}
+	for _, sub := range included {
+		q.remove(sub)
+	}
+
+	if queryErr != nil {
+		q.queryFailures++
+		q.confirmAt = now.Add(retryDelay(q.queryFailures))
+		fmt.Printf("\nTx query failed (attempt %d), retrying in %s: %s\n",
+			q.queryFailures, retryDelay(q.queryFailures), queryErr)
+		return
+	}
+	q.queryFailures = 0
+	if dropped == nil {
+		return
+	}
+	fmt.Printf("\nTx %s of submission %d was not included within %s, broadcasting again\n",
+		dropped.TxHash, dropped.ID, InclusionTimeout)
+	q.synced = false
+	for _, sub := range q.submissions {
+		if sub.TxHash != "" && sub.Sequence >= dropped.Sequence {
+			sub.TxHash = ""
+			q.save(sub)
+		}
+	}
+}
+
+// broadcast broadcasts the submissions that wait for it in order, and stops at the first one that has to be
+// retried later
+func (q *Queue) broadcast(now time.Time) {
+	var rejected []*Submission
+	defer func() {
+		for _, sub := range rejected {
+			q.remove(sub)
+		}
+	}()
+
+	for _, sub := range q.submissions {
+		if sub.TxHash != "" {
+			continue
+		}
+		if now.Before(sub.retryAt) {
+			return
+		}
+		outcome, err := q.send(sub, now)
+		switch outcome {
+		case broadcastAccepted:
+			continue
+		case broadcastRejected:
+			fmt.Printf("\nSubmission %d rejected: %s\n", sub.ID, err)
+			rejected = append(rejected, sub)
+			continue
+		case broadcastResync:
+			q.synced = false
+		}
+		sub.Attempts++
+		sub.retryAt = now.Add(retryDelay(sub.Attempts))
+		q.save(sub)
+		fmt.Printf("\nBroadcast of submission %d failed (attempt %d), retrying in %s: %s\n",
+			sub.ID, sub.Attempts, retryDelay(sub.Attempts), err)
+		return
+	}
+}
+
+// send signs a submission with the next sequence of the account and broadcasts it
+func (q *Queue) send(sub *Submission, now time.Time) (broadcastOutcome, error) {
+	if !q.synced {
+		account, err := q.cliCtx.GetAccount(q.address)
+		if err != nil {
+			return broadcastRetry, fmt.Errorf("validator account error: %s", err)
+		}
+		q.accountNumber, q.sequence = account.GetAccountNumber(), account.GetSequence()
+		q.synced = true
+	}
+
+	txBytes, err := q.txBldr.
+		WithAccountNumber(q.accountNumber).
+		WithSequence(q.sequence).
+		BuildAndSign(q.name, q.passphrase, []sdk.Msg{sub.Msg})
+	if err != nil {
+		return broadcastRetry, fmt.Errorf("msg build/sign error: %s", err)
+	}
+
+	res, err := q.cliCtx.BroadcastTxSync(txBytes)
+	outcome := classifyBroadcast(res, err)
+	if outcome != broadcastAccepted {
+		if err == nil {
+			err = errors.New(res.RawLog)
+		}
+		return outcome, err
+	}
+
+	sub.TxHash = strings.ToUpper(hex.EncodeToString(tmtypes.Tx(txBytes).Hash()))
+	sub.Sequence = q.sequence
+	sub.BroadcastAt = now
+	sub.Attempts = 0
+	q.save(sub)
+	q.sequence++
+	fmt.Printf("\nBroadcast submission %d with sequence %d: tx %s\n", sub.ID, sub.Sequence, sub.TxHash)
+	return broadcastAccepted, nil
+}
+
+// queryNodeTx returns the result of an included transaction from the node, and an error while it is not included
+func (q *Queue) queryNodeTx(txHash string) (*ctypes.ResultTx, error) {
+	hash, err := hex.DecodeString(txHash)
+	if err != nil {
+		return nil, err
+	}
+	node, err := q.cliCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+	return node.Tx(hash, false)
+}
+
+// remove drops a confirmed or rejected submission from the queue and the database
+func (q *Queue) remove(sub *Submission) {
+	for i, queued := range q.submissions {
+		if queued == sub {
+			q.submissions = append(q.submissions[:i], q.submissions[i+1:]...)
+			break
+		}
+	}
+	q.db.DeleteSync(submissionKey(sub.ID))
+
+	q.mtx.Lock()
+	q.pending--
+	q.mtx.Unlock()
+}
+
+func (q *Queue) save(sub *Submission) {
+	if err := saveSubmission(q.cdc, q.db, sub); err != nil {
+		fmt.Printf("Error: submission %d not saved: %s", sub.ID, err)
+	}
+}
+
+// saveSubmission durably records a submission
+func saveSubmission(cdc *amino.Codec, db dbm.DB, sub *Submission) error {
+	bz, err := cdc.MarshalJSON(sub)
+	if err != nil {
+		return err
+	}
+	db.SetSync(submissionKey(sub.ID), bz)
+	return nil
+}
+
+// loadSubmissions returns the submissions recorded in the database, in the order they were submitted
+func loadSubmissions(cdc *amino.Codec, db dbm.DB) ([]*Submission, error) {
+	iterator := dbm.IteratePrefix(db, submissionPrefix)
+	defer iterator.Close()
+
+	var submissions []*Submission
+	for ; iterator.Valid(); iterator.Next() {
+		var sub Submission
+		if err := cdc.UnmarshalJSON(iterator.Value(), &sub); err != nil {
+			return nil, err
+		}
+		submissions = append(submissions, &sub)
+	}
+	return submissions, nil
+}
+
+func submissionKey(id uint64) []byte {
+	key := make([]byte, len(submissionPrefix)+8)
+	copy(key, submissionPrefix)
+	binary.BigEndian.PutUint64(key[len(submissionPrefix):], id)
+	return key
+}
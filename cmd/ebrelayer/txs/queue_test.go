@@ -0,0 +1,116 @@
+package txs
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestClassifyBroadcast(t *testing.T) {
+	sequenceLog := `{"codespace":"sdk","code":4,"message":"signature verification failed; verify correct account sequence and chain-id"}`
+
+	require.Equal(t, broadcastAccepted, classifyBroadcast(sdk.TxResponse{}, nil))
+	require.Equal(t, broadcastAccepted, classifyBroadcast(sdk.TxResponse{}, errors.New("Error broadcasting transaction: Tx already exists in cache")))
+	require.Equal(t, broadcastRetry, classifyBroadcast(sdk.TxResponse{}, errors.New("mempool is full: number of txs 5000 (max: 5000)")))
+	require.Equal(t, broadcastResync, classifyBroadcast(sdk.TxResponse{Code: uint32(sdk.CodeUnauthorized), RawLog: sequenceLog}, nil))
+	require.Equal(t, broadcastResync, classifyBroadcast(sdk.TxResponse{Code: uint32(sdk.CodeInvalidSequence)}, nil))
+	require.Equal(t, broadcastRejected, classifyBroadcast(sdk.TxResponse{Code: uint32(sdk.CodeInsufficientFee)}, nil))
+}
+
+func TestIsTxNotFound(t *testing.T) {
+	require.True(t, isTxNotFound(errors.New("Tx (ABCD) not found")))
+	require.True(t, isTxNotFound(errors.New("Response error: RPC error -32603 - Internal error: Tx (ABCD) not found")))
+	require.False(t, isTxNotFound(errors.New("post failed: Post http://localhost:26657: dial tcp 127.0.0.1:26657: connect: connection refused")))
+	require.False(t, isTxNotFound(errors.New("Transaction indexing is disabled")))
+}
+
+func TestConfirm(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	now := time.Unix(1600000000, 0).UTC()
+
+	claim := types.EthBridgeClaim{ItemID: "0x01", CosmosReceiver: TestValidator, Validator: TestValidator}
+	included := &Submission{ID: 1, Msg: types.NewMsgMakeEthBridgeClaim(claim), TxHash: "AAAA", Sequence: 5,
+		BroadcastAt: now.Add(-2 * InclusionTimeout)}
+	dropped := &Submission{ID: 2, Msg: types.NewMsgMakeEthBridgeClaim(claim), TxHash: "BBBB", Sequence: 6,
+		BroadcastAt: now.Add(-2 * InclusionTimeout)}
+	queries := 0
+	var queryErr error
+	queue := &Queue{
+		cdc:         cdc,
+		db:          dbm.NewMemDB(),
+		pending:     2,
+		submissions: []*Submission{included, dropped},
+		synced:      true,
+		queryTx: func(txHash string) (*ctypes.ResultTx, error) {
+			queries++
+			if queryErr != nil {
+				return nil, queryErr
+			}
+			if txHash == included.TxHash {
+				return &ctypes.ResultTx{Height: 10, TxResult: abci.ResponseDeliverTx{}}, nil
+			}
+			return nil, errors.New("Tx (BBBB) not found")
+		},
+	}
+
+	//While the node can't be reached nothing is broadcast again, and the lookups back off
+	queryErr = errors.New("dial tcp 127.0.0.1:26657: connect: connection refused")
+	queue.confirm(now)
+	require.Equal(t, 1, queries)
+	require.Equal(t, "BBBB", dropped.TxHash)
+	require.True(t, queue.synced)
+	queue.confirm(now.Add(minRetryDelay / 2))
+	require.Equal(t, 1, queries)
+	queue.confirm(now.Add(minRetryDelay))
+	require.Equal(t, 2, queries)
+	require.Equal(t, now.Add(3*minRetryDelay), queue.confirmAt)
+
+	//Once it answers, included transactions are confirmed and only the ones it does not know are broadcast again
+	queryErr = nil
+	queue.confirm(now.Add(3 * minRetryDelay))
+	require.Equal(t, []*Submission{dropped}, queue.submissions)
+	require.Equal(t, 1, queue.Pending())
+	require.Equal(t, "", dropped.TxHash)
+	require.False(t, queue.synced)
+	require.Equal(t, uint64(0), queue.queryFailures)
+}
+
+func TestRetryDelay(t *testing.T) {
+	require.Equal(t, minRetryDelay, retryDelay(1))
+	require.Equal(t, 4*minRetryDelay, retryDelay(3))
+	require.Equal(t, maxRetryDelay, retryDelay(100))
+}
+
+func TestSubmissionPersistence(t *testing.T) {
+	cdc := codec.New()
+	sdk.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	db := dbm.NewMemDB()
+
+	claim := types.EthBridgeClaim{ItemID: "0x01", CosmosReceiver: TestValidator, Validator: TestValidator}
+	pending := &Submission{ID: 2, Msg: types.NewMsgMakeEthBridgeClaim(claim)}
+	broadcast := &Submission{
+		ID:          1,
+		Msg:         types.NewMsgMakeEthBridgeClaim(claim),
+		TxHash:      "ABCD",
+		Sequence:    7,
+		BroadcastAt: time.Unix(1600000000, 0).UTC(),
+	}
+	require.NoError(t, saveSubmission(cdc, db, pending))
+	require.NoError(t, saveSubmission(cdc, db, broadcast))
+
+	submissions, err := loadSubmissions(cdc, db)
+	require.NoError(t, err)
+	require.Equal(t, []*Submission{broadcast, pending}, submissions)
+}
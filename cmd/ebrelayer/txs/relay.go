@@ -4,76 +4,80 @@ package txs
 //      Relay
 //
 //      Builds and encodes EthBridgeClaim Msgs with the
-//      specified variables, before presenting the unsigned
-//      transaction to validators for optional signing.
-//      Once signed, the data packets are sent as transactions
-//      on the Cosmos Bridge.
+//      specified variables, before submitting them to the
+//      queue of the validator's key, which signs and sends
+//      them as transactions on the Cosmos Bridge.
 // ------------------------------------------------------------
 
 import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
 	amino "github.com/tendermint/go-amino"
 
-	"github.com/cosmos/cosmos-sdk/client/context"
-	"github.com/cosmos/cosmos-sdk/client/utils"
-
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
 )
 
-func RelayEvent(chainId string, cdc *amino.Codec, validatorAddress sdk.AccAddress, validatorName string, passphrase string, claim *types.EthBridgeClaim) error {
-
-	cliCtx := context.NewCLIContext().
-		WithCodec(cdc).
-		WithAccountDecoder(cdc)
-
-	cliCtx = cliCtx.
-		WithFromAddress(validatorAddress).
-		WithFromName(validatorName)
-
-	cliCtx.SkipConfirm = true
-
-	txBldr := authtxb.NewTxBuilderFromCLI().
-		WithTxEncoder(utils.GetTxEncoder(cdc)).
-		WithChainID(chainId)
-
-	err := cliCtx.EnsureAccountExistsFromAddr(claim.Validator)
-	if err != nil {
-		fmt.Printf("Validator account error: %s", err)
+// RelayEvent submits the claim of an event, unless the chain would reject it as a duplicate or as too late
+func RelayEvent(queue *Queue, claim *types.EthBridgeClaim) error {
+	if len(preflightClaims(queue.Codec(), []types.EthBridgeClaim{*claim})) == 0 {
+		return nil
 	}
+	return relayClaim(queue, claim)
+}
 
+func relayClaim(queue *Queue, claim *types.EthBridgeClaim) error {
 	msg := ethbridge.NewMsgMakeEthBridgeClaim(*claim)
-
-	err1 := msg.ValidateBasic()
-	if err1 != nil {
-		fmt.Printf("Msg validation error: %s", err1)
+	if !queue.Address().Equals(claim.Validator) {
+		msg = ethbridge.NewMsgMakeEthBridgeClaimFromFeeder(*claim, queue.Address())
 	}
+	return queue.Submit(msg)
+}
 
-	cliCtx.PrintResponse = true
-
-	//prepare tx
-	txBldr, err = utils.PrepareTxBuilder(txBldr, cliCtx)
-	if err != nil {
-		fmt.Printf("Msg prepare error: %s", err)
-		return err
+// RelayEvents submits the claims of several events, such as all the lock events of an ethereum block, in a single
+// transaction. The chain processes each claim on its own, so one rejected claim does not abort the others. Claims
+// the chain would reject as duplicates or as too late are left out.
+func RelayEvents(queue *Queue, claims []types.EthBridgeClaim) error {
+	claims = preflightClaims(queue.Codec(), claims)
+	if len(claims) == 0 {
+		return nil
+	}
+	if len(claims) == 1 {
+		return relayClaim(queue, &claims[0])
 	}
+	var feeder sdk.AccAddress
+	if !queue.Address().Equals(claims[0].Validator) {
+		feeder = queue.Address()
+	}
+	msg := ethbridge.NewMsgMakeEthBridgeClaims(claims, feeder)
+	return queue.Submit(msg)
+}
 
-	// build and sign the transaction
-	txBytes, err := txBldr.BuildAndSign(validatorName, passphrase, []sdk.Msg{msg})
-	if err != nil {
-		fmt.Printf("Msg build/sign error: %s", err)
-		return err
+// RelayReleaseEvent submits the claim that the locked funds of an item were withdrawn or unlocked on ethereum
+func RelayReleaseEvent(queue *Queue, claim *types.EthBridgeReleaseClaim) error {
+	var feeder sdk.AccAddress
+	if !queue.Address().Equals(claim.Validator) {
+		feeder = queue.Address()
 	}
+	msg := ethbridge.NewMsgMakeEthBridgeReleaseClaim(*claim, feeder)
+	return queue.Submit(msg)
+}
 
-	// broadcast to a Tendermint node
-	res, err := cliCtx.BroadcastTx(txBytes)
-	if err != nil {
-		fmt.Printf("Msg broadcast error: %s", err)
-		return err
+// preflightClaims returns the claims whose prophecy the validator has not claimed yet and that is still pending,
+// logging why the others are skipped. A claim whose prophecy cannot be queried is kept.
+func preflightClaims(cdc *amino.Codec, claims []types.EthBridgeClaim) []types.EthBridgeClaim {
+	var needed []types.EthBridgeClaim
+	for _, claim := range claims {
+		reason, err := PreflightClaim(cdc, claim)
+		if err != nil {
+			fmt.Printf("\nPre-flight query of item %s failed, relaying its claim anyway: %s", claim.ItemID, err)
+		}
+		if reason != "" {
+			fmt.Printf("\nSkipping claim of item %s: %s", claim.ItemID, reason)
+			continue
+		}
+		needed = append(needed, claim)
 	}
-	cliCtx.PrintOutput(res)
-	return err
+	return needed
 }
@@ -12,6 +12,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders"
 )
 
 // export the state of gaia for a genesis file
@@ -39,6 +41,8 @@ func (app *ethereumBridgeApp) ExportAppStateAndValidators(forZeroHeight bool, ja
 		auth.ExportGenesis(ctx, app.accountKeeper, app.feeCollectionKeeper),
 		bank.ExportGenesis(ctx, app.bankKeeper),
 		staking.ExportGenesis(ctx, app.stakingKeeper),
+		ethbridge.ExportGenesis(ctx, app.ethBridgeKeeper),
+		ethheaders.ExportGenesis(ctx, app.ethHeadersKeeper),
 	)
 	appState, err = codec.MarshalJSONIndent(app.cdc, genState)
 	if err != nil {
@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders"
 )
 
 type GenesisAccount struct {
@@ -26,11 +28,13 @@ type GenesisAccount struct {
 
 // GenesisState represents chain state at the start of the chain. Any initial state (account balances) are stored here.
 type GenesisState struct {
-	Accounts    []GenesisAccount     `json:"accounts"`
-	AuthData    auth.GenesisState    `json:"auth"`
-	BankData    bank.GenesisState    `json:"bank"`
-	StakingData staking.GenesisState `json:"staking"`
-	GenTxs      []json.RawMessage    `json:"gentxs"`
+	Accounts       []GenesisAccount        `json:"accounts"`
+	AuthData       auth.GenesisState       `json:"auth"`
+	BankData       bank.GenesisState       `json:"bank"`
+	StakingData    staking.GenesisState    `json:"staking"`
+	EthBridgeData  ethbridge.GenesisState  `json:"ethbridge"`
+	EthHeadersData ethheaders.GenesisState `json:"ethheaders"`
+	GenTxs         []json.RawMessage       `json:"gentxs"`
 }
 
 // convert GenesisAccount to auth.BaseAccount
@@ -70,13 +74,17 @@ func (ga *GenesisAccount) ToAccount() auth.Account {
 
 func NewGenesisState(accounts []GenesisAccount, authData auth.GenesisState,
 	bankData bank.GenesisState,
-	stakingData staking.GenesisState) GenesisState {
+	stakingData staking.GenesisState,
+	ethBridgeData ethbridge.GenesisState,
+	ethHeadersData ethheaders.GenesisState) GenesisState {
 
 	return GenesisState{
-		Accounts:    accounts,
-		AuthData:    authData,
-		BankData:    bankData,
-		StakingData: stakingData,
+		Accounts:       accounts,
+		AuthData:       authData,
+		BankData:       bankData,
+		StakingData:    stakingData,
+		EthBridgeData:  ethBridgeData,
+		EthHeadersData: ethHeadersData,
 	}
 }
 
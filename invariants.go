@@ -0,0 +1,35 @@
+package app
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// invariantCheckPeriod is the number of blocks between runtime invariant checks
+const invariantCheckPeriod = 100
+
+type invariantRoute struct {
+	moduleName string
+	route      string
+	invar      sdk.Invariant
+}
+
+// invariantRegistry collects the invariants registered by the modules, satisfying their CrisisKeeper interface
+type invariantRegistry struct {
+	routes []invariantRoute
+}
+
+// RegisterRoute registers an invariant to be asserted at runtime
+func (registry *invariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	registry.routes = append(registry.routes, invariantRoute{moduleName, route, invar})
+}
+
+// assertRuntimeInvariants halts the chain if any registered invariant is broken
+func (app *ethereumBridgeApp) assertRuntimeInvariants(ctx sdk.Context) {
+	for _, route := range app.invariants.routes {
+		if err := route.invar(ctx); err != nil {
+			panic(fmt.Errorf("invariant broken: %s/%s: %v", route.moduleName, route.route, err))
+		}
+	}
+}
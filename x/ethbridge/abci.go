@@ -0,0 +1,13 @@
+package ethbridge
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker mints the pending releases that are due, prunes the mint records that have fallen out of the
+// mint window and checkpoints the validator set when it changed
+func EndBlocker(ctx sdk.Context, keeper Keeper) {
+	keeper.ReleaseDuePendingReleases(ctx)
+	keeper.PruneMintRecords(ctx)
+	keeper.UpdateValset(ctx)
+}
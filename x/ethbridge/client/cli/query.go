@@ -6,41 +6,498 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/spf13/cobra"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+	"github.com/spf13/cobra"
 )
 
 // GetCmdGetEthBridgeProphecy queries information about a specific prophecy
 func GetCmdGetEthBridgeProphecy(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "get-prophecy nonce ethereum-sender",
+		Use:   "get-prophecy item-id",
 		Short: "get prophecy",
-		Args:  cobra.ExactArgs(2),
+		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
-			nonce := args[0]
+			itemID := args[0]
 
-			nonceString, err := strconv.Atoi(nonce)
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthProphecyParams(itemID))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryEthProphecy)
+			res, err := cliCtx.QueryWithData(route, bz)
 			if err != nil {
 				fmt.Printf(err.Error())
 				return nil
 			}
-			ethereumSender := args[1]
 
-			bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthProphecyParams(nonceString, ethereumSender))
+			var out types.QueryEthProphecyResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetEthBridgeProphecyByTx queries the prophecies claimed for a specific ethereum transaction
+func GetCmdGetEthBridgeProphecyByTx(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get-prophecies-by-tx ethereum-tx-hash",
+		Short: "get the prophecies claimed for an ethereum transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			ethereumTxHash := args[0]
+
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthProphecyByTxParams(ethereumTxHash))
 			if err != nil {
 				return err
 			}
 
-			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryEthProphecy)
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryEthProphecyByTx)
 			res, err := cliCtx.QueryWithData(route, bz)
 			if err != nil {
 				fmt.Printf(err.Error())
 				return nil
 			}
 
-			var out types.QueryEthProphecyResponse
+			var out types.QueryEthProphecyByTxResponse
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetSupply queries the bridged supply of one or all denominations
+func GetCmdGetSupply(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "supply [denom]",
+		Short: "get the minted, burned and outstanding supply of bridged coins",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			denom := ""
+			if len(args) == 1 {
+				denom = args[0]
+			}
+
+			bz, err := cdc.MarshalJSON(ethbridge.NewQuerySupplyParams(denom))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QuerySupply)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			if denom != "" {
+				var out types.Supply
+				cdc.MustUnmarshalJSON(res, &out)
+				return cliCtx.PrintOutput(out)
+			}
+			var out types.Supplies
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetParams queries the params of the ethbridge module
+func GetCmdGetParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "get the ethbridge admin, mint window and mint limits",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryParams)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetQueuedMints queries the successful claims held back by the circuit breaker or the mint limits
+func GetCmdGetQueuedMints(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "queued-mints",
+		Short: "get the successful claims queued until they are released by the admin",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryQueuedMints)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.QueuedMints
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetMintingPaused queries whether the circuit breaker is holding back all minting
+func GetCmdGetMintingPaused(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "minting-paused",
+		Short: "get whether minting of bridged coins is paused",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryMintingPaused)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out bool
+			cdc.MustUnmarshalJSON(res, &out)
+			fmt.Println(out)
+			return nil
+		},
+	}
+}
+
+// GetCmdGetPendingReleases queries the large transfers waiting out the release delay
+func GetCmdGetPendingReleases(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "pending-releases",
+		Short: "get the transfers above a delay threshold that are waiting to be minted",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryPendingReleases)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.PendingReleases
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetFeeRewards queries the bridge fee rewards paid to one or all validators
+func GetCmdGetFeeRewards(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "fee-rewards [validator-address]",
+		Short: "get the bridge fees paid to validators for attesting to successful claims",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			var validator sdk.ValAddress
+			if len(args) == 1 {
+				var err error
+				validator, err = sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryFeeRewardsParams(validator))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryFeeRewards)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.FeeRewards
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetFeeders queries the accounts validators registered to sign claims on their behalf
+func GetCmdGetFeeders(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "feeders",
+		Short: "get the feeder accounts that sign bridge claims on behalf of validators",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryFeeders)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.Feeders
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetWithdrawals queries the peggy items whose locked funds were withdrawn or unlocked on ethereum
+func GetCmdGetWithdrawals(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "withdrawals",
+		Short: "get the peggy items whose locked funds were released on ethereum and how their coins were reconciled",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryWithdrawals)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.Withdrawals
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetDeficitReport queries the coins minted for withdrawn items that could not be clawed back
+func GetCmdGetDeficitReport(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "deficit-report",
+		Short: "get the coins minted for items withdrawn on ethereum that could not be clawed back",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryDeficitReport)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.DeficitReport
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetRefunds queries the peggy items whose lock prophecy failed and that await an unlock to their sender
+func GetCmdGetRefunds(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "refunds",
+		Short: "get the peggy items whose lock prophecy failed and that should be unlocked back to their ethereum sender",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryRefunds)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.Refunds
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetEthereumKeys queries the ethereum keys registered by one or all validators
+func GetCmdGetEthereumKeys(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "ethereum-keys [validator-address]",
+		Short: "get the ethereum addresses validators registered to identify them on ethereum",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			var validator sdk.ValAddress
+			if len(args) == 1 {
+				var err error
+				validator, err = sdk.ValAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthereumKeysParams(validator))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryEthereumKeys)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.EthereumKeys
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetOutgoingTransfers queries the transfers of burned coins to ethereum
+func GetCmdGetOutgoingTransfers(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "outgoing-transfers",
+		Short: "get the transfers of burned coins to ethereum that validators attest to",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryOutgoingTransfers)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.OutgoingTransfers
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetValsets queries the checkpoints of the validators that attest on ethereum
+func GetCmdGetValsets(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "valsets",
+		Short: "get the checkpoints of the bonded validators with a registered ethereum key and their powers",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryValsets)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.Valsets
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetAttestations queries the validator signatures collected for transfers and valsets
+func GetCmdGetAttestations(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "attestations [kind] [nonce]",
+		Short: "get the validator signatures collected for transfers and valsets, or the signature bundle of one of them",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			var kind string
+			var nonce uint64
+			if len(args) > 0 {
+				kind = args[0]
+				if !ethbridge.IsValidAttestationKind(kind) {
+					return ethbridge.ErrInvalidAttestationKind(ethbridge.DefaultCodespace)
+				}
+			}
+			if len(args) > 1 {
+				var err error
+				nonce, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			bz, err := cdc.MarshalJSON(ethbridge.NewQueryAttestationsParams(kind, nonce))
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryAttestations)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			if nonce != 0 {
+				var out types.Attestation
+				cdc.MustUnmarshalJSON(res, &out)
+				return cliCtx.PrintOutput(out)
+			}
+			var out types.Attestations
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetDust queries the remainders rounded off bridged amounts by the token scales
+func GetCmdGetDust(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "dust",
+		Short: "get the remainders rounded off bridged amounts when scaling them to cosmos decimals",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryDust)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.Dusts
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetPostMints queries the post-mint actions of bridged items
+func GetCmdGetPostMints(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "post-mints",
+		Short: "get the post-mint actions requested by the memos of bridged items, and whether they ran",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethbridge.QueryPostMints)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.PostMints
 			cdc.MustUnmarshalJSON(res, &out)
 			return cliCtx.PrintOutput(out)
 		},
@@ -1,13 +1,17 @@
 package cli
 
 import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
 	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/client/utils"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/spf13/cobra"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+	"github.com/spf13/cobra"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
@@ -16,9 +20,9 @@ import (
 // GetCmdMakeEthBridgeClaim is the CLI command for making a claim on an ethereum prophecy
 func GetCmdMakeEthBridgeClaim(cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
-		Use:   "make-claim nonce ethereum-sender-address cosmos-receiver-address validator-address amount",
-		Short: "make a claim on an ethereum prophecy",
-		Args:  cobra.ExactArgs(5),
+		Use:   "make-claim item-id ethereum-tx-hash ethereum-log-index nonce ethereum-sender-address cosmos-receiver-address validator-address amount",
+		Short: "make a claim on an ethereum prophecy, as the validator or as its registered feeder. The receiver can carry the post-mint memo of the peggy recipient",
+		Args:  cobra.ExactArgs(8),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
 
@@ -28,29 +32,701 @@ func GetCmdMakeEthBridgeClaim(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			nonce, stringError := strconv.Atoi(args[0])
+			itemID := args[0]
+			ethereumTxHash := args[1]
+
+			ethereumLogIndex, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			nonce, stringError := strconv.Atoi(args[3])
 			if stringError != nil {
 				return stringError
 			}
 
-			ethereumSender := args[1]
-			cosmosReceiver, err := sdk.AccAddressFromBech32(args[2])
+			ethereumSender := args[4]
+			cosmosReceiver, postMintActions, err := types.ParseRecipient([]byte(args[5]))
 			if err != nil {
 				return err
 			}
 
-			validator, err := sdk.AccAddressFromBech32(args[3])
+			validator, err := sdk.AccAddressFromBech32(args[6])
 			if err != nil {
 				return err
 			}
 
-			amount, err := sdk.ParseCoins(args[4])
+			amount, err := sdk.ParseCoins(args[7])
 			if err != nil {
 				return err
 			}
 
-			ethBridgeClaim := types.NewEthBridgeClaim(nonce, ethereumSender, cosmosReceiver, validator, amount)
+			ethBridgeClaim := types.NewEthBridgeClaim(itemID, ethereumTxHash, ethereumLogIndex, nonce, ethereumSender, cosmosReceiver, validator, amount, postMintActions)
 			msg := types.NewMsgMakeEthBridgeClaim(ethBridgeClaim)
+			if !cliCtx.GetFromAddress().Equals(validator) {
+				msg = types.NewMsgMakeEthBridgeClaimFromFeeder(ethBridgeClaim, cliCtx.GetFromAddress())
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdMakeEthBridgeClaims is the CLI command for making a batch of claims read from a JSON file
+func GetCmdMakeEthBridgeClaims(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "make-claims claims-file",
+		Short: "make every claim of a JSON file containing a list of claims of one validator in a single transaction",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var ethBridgeClaims []types.EthBridgeClaim
+			err = cdc.UnmarshalJSON(bz, &ethBridgeClaims)
+			if err != nil {
+				return err
+			}
+
+			var feeder sdk.AccAddress
+			if len(ethBridgeClaims) > 0 && !cliCtx.GetFromAddress().Equals(ethBridgeClaims[0].Validator) {
+				feeder = cliCtx.GetFromAddress()
+			}
+
+			msg := types.NewMsgMakeEthBridgeClaims(ethBridgeClaims, feeder)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdMakeEthBridgeProvenClaim is the CLI command for making a claim backed by a receipt proof of its LogLock log
+func GetCmdMakeEthBridgeProvenClaim(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "make-proven-claim proven-claim-file",
+		Short: "make the claim of a JSON file holding a claim, the receipt proof of its lock log and the log's index in the receipt",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			bz, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			var msg types.MsgMakeEthBridgeProvenClaim
+			err = cdc.UnmarshalJSON(bz, &msg)
+			if err != nil {
+				return err
+			}
+
+			var feeder sdk.AccAddress
+			if !cliCtx.GetFromAddress().Equals(msg.Validator) {
+				feeder = cliCtx.GetFromAddress()
+			}
+
+			msg = types.NewMsgMakeEthBridgeProvenClaim(msg.EthBridgeClaim, msg.ReceiptProof, msg.ReceiptLogIndex, feeder)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdMakeEthBridgeReleaseClaim is the CLI command for claiming that the locked funds of a peggy item were
+// withdrawn or unlocked on ethereum
+func GetCmdMakeEthBridgeReleaseClaim(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "make-release-claim item-id ethereum-tx-hash ethereum-log-index withdraw|unlock ethereum-receiver-address validator-address amount",
+		Short: "claim that the locked funds of a peggy item were withdrawn or unlocked on ethereum",
+		Args:  cobra.ExactArgs(7),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			ethereumLogIndex, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			validator, err := sdk.AccAddressFromBech32(args[5])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[6])
+			if err != nil {
+				return err
+			}
+
+			var feeder sdk.AccAddress
+			if !cliCtx.GetFromAddress().Equals(validator) {
+				feeder = cliCtx.GetFromAddress()
+			}
+
+			releaseClaim := types.NewEthBridgeReleaseClaim(args[0], args[1], ethereumLogIndex, args[3], args[4], validator, amount)
+			msg := types.NewMsgMakeEthBridgeReleaseClaim(releaseClaim, feeder)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdBurn is the CLI command for burning bridged coins
+func GetCmdBurn(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "burn ethereum-receiver-address amount",
+		Short: "burn bridged coins so they can be released to an ethereum address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			ethereumReceiver := args[0]
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgBurn(cliCtx.GetFromAddress(), ethereumReceiver, amount)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetMintingPaused is the CLI command for the admin to trip or reset the circuit breaker
+func GetCmdSetMintingPaused(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-minting-paused true|false",
+		Short: "pause or resume minting of bridged coins; successful claims are queued while minting is paused",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			paused, err := strconv.ParseBool(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetMintingPaused(cliCtx.GetFromAddress(), paused)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetMintLimits is the CLI command for the admin to change the mint window and mint limits
+func GetCmdSetMintLimits(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-mint-limits mint-window [denom:denom-limit:receiver-limit...]",
+		Short: "set the number of blocks in the mint window and the amounts of each denomination that can be minted within it, 0 meaning unlimited",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			mintWindow, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			mintLimits := types.MintLimits{}
+			for _, arg := range args[1:] {
+				mintLimit, err := parseMintLimit(arg)
+				if err != nil {
+					return err
+				}
+				mintLimits = append(mintLimits, mintLimit)
+			}
+
+			msg := types.NewMsgSetMintLimits(cliCtx.GetFromAddress(), mintWindow, mintLimits)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdReleaseQueuedMint is the CLI command for the admin to mint a queued claim
+func GetCmdReleaseQueuedMint(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "release-queued-mint item-id",
+		Short: "mint the coins of a successful claim held back by the circuit breaker or the mint limits",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgReleaseQueuedMint(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetReleaseDelay is the CLI command for the admin to change the release delay and delay thresholds
+func GetCmdSetReleaseDelay(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-release-delay release-delay [delay-thresholds]",
+		Short: "set the number of blocks transfers above the per-denomination delay thresholds wait before they are minted",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			releaseDelay, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			delayThresholds := sdk.Coins{}
+			if len(args) == 2 {
+				delayThresholds, err = sdk.ParseCoins(args[1])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetReleaseDelay(cliCtx.GetFromAddress(), releaseDelay, delayThresholds)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdCancelRelease is the CLI command for the admin or guardian to cancel a pending release
+func GetCmdCancelRelease(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "cancel-release item-id",
+		Short: "cancel a large transfer waiting in the pending-release queue so its coins are never minted",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRelease(cliCtx.GetFromAddress(), args[0])
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetConsensusTiers is the CLI command for the admin to change the consensus tiers
+func GetCmdSetConsensusTiers(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-consensus-tiers [consensus-needed:thresholds...]",
+		Short: "set the consensus requested from the oracle for claims above per-denomination thresholds, eg. 0.9:1000ethereum",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			consensusTiers := types.ConsensusTiers{}
+			for _, arg := range args {
+				parts := strings.SplitN(arg, ":", 2)
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid consensus tier %s, expected consensus-needed:thresholds", arg)
+				}
+				consensusNeeded, decErr := sdk.NewDecFromStr(parts[0])
+				if decErr != nil {
+					return decErr
+				}
+				thresholds, err := sdk.ParseCoins(parts[1])
+				if err != nil {
+					return err
+				}
+				consensusTiers = append(consensusTiers, types.NewConsensusTier(thresholds, consensusNeeded))
+			}
+
+			msg := types.NewMsgSetConsensusTiers(cliCtx.GetFromAddress(), consensusTiers)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetBridgeFees is the CLI command for the admin to change the bridge fees
+func GetCmdSetBridgeFees(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-bridge-fees [denom:rate:flat...]",
+		Short: "set the fees deducted from minted coins and paid to the attesting validators, eg. ethereum:0.001:10",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			bridgeFees := types.BridgeFees{}
+			for _, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 3 {
+					return fmt.Errorf("invalid bridge fee %s, expected denom:rate:flat", arg)
+				}
+				rate, decErr := sdk.NewDecFromStr(parts[1])
+				if decErr != nil {
+					return decErr
+				}
+				flat, ok := sdk.NewIntFromString(parts[2])
+				if !ok {
+					return fmt.Errorf("invalid flat fee %s", parts[2])
+				}
+				bridgeFees = append(bridgeFees, types.NewBridgeFee(parts[0], rate, flat))
+			}
+
+			msg := types.NewMsgSetBridgeFees(cliCtx.GetFromAddress(), bridgeFees)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetTokenScales is the CLI command for replacing the decimal scaling of bridged tokens
+func GetCmdSetTokenScales(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-token-scales [ethereum-denom:cosmos-denom:ethereum-decimals:cosmos-decimals:dust-rule...]",
+		Short: "set the decimal scaling of bridged tokens and how dust is handled, eg. ethereum:ueth:18:6:round_down",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			tokenScales := types.TokenScales{}
+			for _, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 5 {
+					return fmt.Errorf("invalid token scale %s, expected ethereum-denom:cosmos-denom:ethereum-decimals:cosmos-decimals:dust-rule", arg)
+				}
+				ethereumDecimals, err := strconv.ParseUint(parts[2], 10, 8)
+				if err != nil {
+					return fmt.Errorf("invalid ethereum decimals %s", parts[2])
+				}
+				cosmosDecimals, err := strconv.ParseUint(parts[3], 10, 8)
+				if err != nil {
+					return fmt.Errorf("invalid cosmos decimals %s", parts[3])
+				}
+				tokenScales = append(tokenScales, types.NewTokenScale(parts[0], parts[1],
+					uint8(ethereumDecimals), uint8(cosmosDecimals), parts[4]))
+			}
+
+			msg := types.NewMsgSetTokenScales(cliCtx.GetFromAddress(), tokenScales)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSetTokenDenoms is the CLI command for replacing the ERC20 tokens that can be bridged
+func GetCmdSetTokenDenoms(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-token-denoms [token-address:denom...]",
+		Short: "set the ERC20 tokens that can be bridged and the denom their claims carry, eg. 0x...:dai",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			tokenDenoms := types.TokenDenoms{}
+			for _, arg := range args {
+				parts := strings.Split(arg, ":")
+				if len(parts) != 2 {
+					return fmt.Errorf("invalid token denom %s, expected token-address:denom", arg)
+				}
+				tokenDenoms = append(tokenDenoms, types.NewTokenDenom(parts[0], parts[1]))
+			}
+
+			msg := types.NewMsgSetTokenDenoms(cliCtx.GetFromAddress(), tokenDenoms)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+func parseMintLimit(arg string) (types.MintLimit, error) {
+	parts := strings.Split(arg, ":")
+	if len(parts) != 3 {
+		return types.MintLimit{}, fmt.Errorf("invalid mint limit %s, expected denom:denom-limit:receiver-limit", arg)
+	}
+	denomLimit, ok := sdk.NewIntFromString(parts[1])
+	if !ok {
+		return types.MintLimit{}, fmt.Errorf("invalid denom limit %s", parts[1])
+	}
+	receiverLimit, ok := sdk.NewIntFromString(parts[2])
+	if !ok {
+		return types.MintLimit{}, fmt.Errorf("invalid receiver limit %s", parts[2])
+	}
+	return types.NewMintLimit(parts[0], denomLimit, receiverLimit), nil
+}
+
+// GetCmdSetFeeder is the CLI command for a validator to register the account that signs claims on its behalf
+func GetCmdSetFeeder(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "set-feeder [feeder-address]",
+		Short: "register the account that signs bridge claims for the validator operated by the from key, or remove it",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			var feeder sdk.AccAddress
+			if len(args) == 1 {
+				var err error
+				feeder, err = sdk.AccAddressFromBech32(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgSetFeeder(sdk.ValAddress(cliCtx.GetFromAddress()), feeder)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdRegisterEthereumKey is the CLI command for a validator to register or rotate its ethereum key
+func GetCmdRegisterEthereumKey(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "register-ethereum-key [ethereum-address] [signature]",
+		Short: "register the ethereum key of the validator operated by the from key",
+		Long: `Register or rotate the ethereum address of the validator operated by the from key. The signature is the
+hex encoded personal_sign signature made with the ethereum key, from any ethereum wallet, over the text
+
+  ethbridge ethereum key registration
+  chain-id: [chain-id]
+  validator: [valoper address]
+
+where the lines are separated by single newlines, so it can't be replayed on another chain.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			signature, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterEthereumKey(sdk.ValAddress(cliCtx.GetFromAddress()), args[0], signature)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			err = msg.VerifySignature(txBldr.ChainID())
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
+
+// GetCmdSignAttestation is the CLI command for a validator to sign the checkpoint of an outgoing transfer or valset
+func GetCmdSignAttestation(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "sign-attestation kind nonce validator-address signature",
+		Short: "add the validator's ethereum signature over the checkpoint of a transfer or valset, as the validator or as its registered feeder",
+		Long: `Add the signature of a validator over the checkpoint of an outgoing transfer or valset. The kind is transfer or
+valset, and the signature is the hex encoded personal_sign signature of the checkpoint shown by
+"query ethbridge attestations kind nonce", made with the validator's registered ethereum key.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			nonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			validator, err := sdk.ValAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			signature, err := hex.DecodeString(strings.TrimPrefix(args[3], "0x"))
+			if err != nil {
+				return err
+			}
+
+			var feeder sdk.AccAddress
+			if !cliCtx.GetFromAddress().Equals(sdk.AccAddress(validator)) {
+				feeder = cliCtx.GetFromAddress()
+			}
+			msg := types.NewMsgSignAttestation(args[0], nonce, validator, signature, feeder)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
@@ -2,8 +2,8 @@ package client
 
 import (
 	"github.com/cosmos/cosmos-sdk/client"
-	"github.com/spf13/cobra"
 	ethbridgecmd "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/client/cli"
+	"github.com/spf13/cobra"
 	amino "github.com/tendermint/go-amino"
 )
 
@@ -27,6 +27,23 @@ func (mc ModuleClient) GetQueryCmd() *cobra.Command {
 
 	ethBBridgeQueryCmd.AddCommand(client.GetCommands(
 		ethbridgecmd.GetCmdGetEthBridgeProphecy(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetEthBridgeProphecyByTx(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetSupply(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetParams(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetQueuedMints(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetMintingPaused(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetPendingReleases(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetFeeRewards(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetFeeders(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetWithdrawals(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetDeficitReport(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetRefunds(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetEthereumKeys(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetOutgoingTransfers(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetValsets(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetAttestations(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetDust(mc.queryRoute, mc.cdc),
+		ethbridgecmd.GetCmdGetPostMints(mc.queryRoute, mc.cdc),
 	)...)
 
 	return ethBBridgeQueryCmd
@@ -41,6 +58,22 @@ func (mc ModuleClient) GetTxCmd() *cobra.Command {
 
 	ethBridgeTxCmd.AddCommand(client.PostCommands(
 		ethbridgecmd.GetCmdMakeEthBridgeClaim(mc.cdc),
+		ethbridgecmd.GetCmdMakeEthBridgeClaims(mc.cdc),
+		ethbridgecmd.GetCmdMakeEthBridgeProvenClaim(mc.cdc),
+		ethbridgecmd.GetCmdMakeEthBridgeReleaseClaim(mc.cdc),
+		ethbridgecmd.GetCmdBurn(mc.cdc),
+		ethbridgecmd.GetCmdSetMintingPaused(mc.cdc),
+		ethbridgecmd.GetCmdSetMintLimits(mc.cdc),
+		ethbridgecmd.GetCmdReleaseQueuedMint(mc.cdc),
+		ethbridgecmd.GetCmdSetReleaseDelay(mc.cdc),
+		ethbridgecmd.GetCmdCancelRelease(mc.cdc),
+		ethbridgecmd.GetCmdSetConsensusTiers(mc.cdc),
+		ethbridgecmd.GetCmdSetBridgeFees(mc.cdc),
+		ethbridgecmd.GetCmdSetTokenScales(mc.cdc),
+		ethbridgecmd.GetCmdSetTokenDenoms(mc.cdc),
+		ethbridgecmd.GetCmdSetFeeder(mc.cdc),
+		ethbridgecmd.GetCmdRegisterEthereumKey(mc.cdc),
+		ethbridgecmd.GetCmdSignAttestation(mc.cdc),
 	)...)
 
 	return ethBridgeTxCmd
@@ -20,23 +20,53 @@ import (
 )
 
 const (
+	restItemID         = "itemID"
+	restEthereumTxHash = "ethereumTxHash"
+	restDenom          = "denom"
+	restValidator      = "validator"
+	restKind           = "kind"
 	restNonce          = "nonce"
-	restEthereumSender = "ethereumSender"
 )
 
 // RegisterRoutes - Central function to define routes that get registered by the main application
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, queryRoute string) {
 	r.HandleFunc(fmt.Sprintf("/%s/prophecies", queryRoute), makeClaimHandler(cdc, cliCtx)).Methods("POST")
-	r.HandleFunc(fmt.Sprintf("/%s/prophecies/{%s}/{%s}", queryRoute, restNonce, restEthereumSender), getProphecyHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/prophecies/{%s}", queryRoute, restItemID), getProphecyHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/txs/{%s}/prophecies", queryRoute, restEthereumTxHash), getProphecyByTxHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/burns", queryRoute), burnHandler(cdc, cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/%s/supply", queryRoute), getSupplyHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/supply/{%s}", queryRoute, restDenom), getSupplyHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/params", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryParams)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/queued-mints", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryQueuedMints)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/minting-paused", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryMintingPaused)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/pending-releases", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryPendingReleases)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/fee-rewards", queryRoute), getFeeRewardsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/fee-rewards/{%s}", queryRoute, restValidator), getFeeRewardsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/feeders", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryFeeders)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/withdrawals", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryWithdrawals)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/deficit-report", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryDeficitReport)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/refunds", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryRefunds)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/dust", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryDust)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/post-mints", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryPostMints)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/ethereum-keys", queryRoute), getEthereumKeysHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/ethereum-keys/{%s}", queryRoute, restValidator), getEthereumKeysHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/outgoing-transfers", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryOutgoingTransfers)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/valsets", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryValsets)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/attestations", queryRoute), getAttestationsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/attestations/{%s}", queryRoute, restKind), getAttestationsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/attestations/{%s}/{%s}", queryRoute, restKind, restNonce), getAttestationsHandler(cdc, cliCtx, queryRoute)).Methods("GET")
 }
 
 type makeEthClaimReq struct {
-	BaseReq        rest.BaseReq `json:"base_req"`
-	Nonce          int          `json:"nonce"`
-	EthereumSender string       `json:"ethereum_sender"`
-	CosmosReceiver string       `json:"cosmos_receiver"`
-	Validator      string       `json:"validator"`
-	Amount         string       `json:"amount"`
+	BaseReq          rest.BaseReq `json:"base_req"`
+	ItemID           string       `json:"item_id"`
+	EthereumTxHash   string       `json:"ethereum_tx_hash"`
+	EthereumLogIndex uint64       `json:"ethereum_log_index"`
+	Nonce            int          `json:"nonce"`
+	EthereumSender   string       `json:"ethereum_sender"`
+	CosmosReceiver   string       `json:"cosmos_receiver"`
+	Validator        string       `json:"validator"`
+	Amount           string       `json:"amount"`
 }
 
 func makeClaimHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
@@ -54,7 +84,7 @@ func makeClaimHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerF
 		}
 
 		ethereumSender := req.EthereumSender
-		cosmosReceiver, err2 := sdk.AccAddressFromBech32(req.CosmosReceiver)
+		cosmosReceiver, postMintActions, err2 := types.ParseRecipient([]byte(req.CosmosReceiver))
 		if err2 != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err2.Error())
 			return
@@ -72,8 +102,11 @@ func makeClaimHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerF
 		}
 
 		// create the message
-		ethBridgeClaim := types.NewEthBridgeClaim(req.Nonce, ethereumSender, cosmosReceiver, validator, amount)
+		ethBridgeClaim := types.NewEthBridgeClaim(req.ItemID, req.EthereumTxHash, req.EthereumLogIndex, req.Nonce, ethereumSender, cosmosReceiver, validator, amount, postMintActions)
 		msg := ethbridge.NewMsgMakeEthBridgeClaim(ethBridgeClaim)
+		if from, err := sdk.AccAddressFromBech32(baseReq.From); err == nil && !from.Equals(validator) {
+			msg = ethbridge.NewMsgMakeEthBridgeClaimFromFeeder(ethBridgeClaim, from)
+		}
 		err5 := msg.ValidateBasic()
 		if err5 != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err5.Error())
@@ -84,25 +117,214 @@ func makeClaimHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerF
 	}
 }
 
+type burnReq struct {
+	BaseReq          rest.BaseReq `json:"base_req"`
+	EthereumReceiver string       `json:"ethereum_receiver"`
+	Amount           string       `json:"amount"`
+}
+
+func burnHandler(cdc *codec.Codec, cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req burnReq
+
+		if !rest.ReadRESTReq(w, r, cdc, &req) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse request")
+			return
+		}
+
+		baseReq := req.BaseReq.Sanitize()
+		if !baseReq.ValidateBasic(w) {
+			return
+		}
+
+		sender, err := sdk.AccAddressFromBech32(baseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		amount, err := sdk.ParseCoins(req.Amount)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// create the message
+		msg := ethbridge.NewMsgBurn(sender, req.EthereumReceiver, amount)
+		err = msg.ValidateBasic()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		clientrest.WriteGenerateStdTxResponse(w, cdc, cliCtx, baseReq, []sdk.Msg{msg})
+	}
+}
+
 func getProphecyHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
-		nonce := vars[restNonce]
+		itemID := vars[restItemID]
+
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthProphecyParams(itemID))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryEthProphecy)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getProphecyByTxHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		ethereumTxHash := vars[restEthereumTxHash]
 
-		nonceString, err := strconv.Atoi(nonce)
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthProphecyByTxParams(ethereumTxHash))
 		if err != nil {
-			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
-		ethereumSender := vars[restEthereumSender]
 
-		bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthProphecyParams(nonceString, ethereumSender))
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryEthProphecyByTx)
+		res, err := cliCtx.QueryWithData(route, bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
 			return
 		}
 
-		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryEthProphecy)
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getSupplyHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		denom := vars[restDenom]
+
+		bz, err := cdc.MarshalJSON(ethbridge.NewQuerySupplyParams(denom))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QuerySupply)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, endpoint)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getFeeRewardsHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		var validator sdk.ValAddress
+		if vars[restValidator] != "" {
+			var err error
+			validator, err = sdk.ValAddressFromBech32(vars[restValidator])
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryFeeRewardsParams(validator))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryFeeRewards)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getEthereumKeysHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		var validator sdk.ValAddress
+		if vars[restValidator] != "" {
+			var err error
+			validator, err = sdk.ValAddressFromBech32(vars[restValidator])
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryEthereumKeysParams(validator))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryEthereumKeys)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getAttestationsHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		kind := vars[restKind]
+		if kind != "" && !ethbridge.IsValidAttestationKind(kind) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("invalid attestation kind %s", kind))
+			return
+		}
+		var nonce uint64
+		if vars[restNonce] != "" {
+			var err error
+			nonce, err = strconv.ParseUint(vars[restNonce], 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		bz, err := cdc.MarshalJSON(ethbridge.NewQueryAttestationsParams(kind, nonce))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryAttestations)
 		res, err := cliCtx.QueryWithData(route, bz)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
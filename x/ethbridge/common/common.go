@@ -1,8 +1,55 @@
 package common
 
-import gethCommon "github.com/ethereum/go-ethereum/common"
+import (
+	"errors"
+	"fmt"
+	"regexp"
 
-//IsValidEthereumAddress returns true if address is valid
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var ethHashRegex = regexp.MustCompile("^0x[0-9a-fA-F]{64}$")
+
+// IsValidEthereumAddress returns true if address is valid
 func IsValidEthAddress(s string) bool {
 	return gethCommon.IsHexAddress(s)
 }
+
+// IsValidEthHash returns true if s is a 0x-prefixed, hex-encoded 32 byte value such as a transaction hash or a Peggy item id
+func IsValidEthHash(s string) bool {
+	return ethHashRegex.MatchString(s)
+}
+
+// NormalizeEthHash returns the canonical lowercase hex representation of a 32 byte hash
+func NormalizeEthHash(s string) string {
+	return gethCommon.HexToHash(s).Hex()
+}
+
+// NormalizeEthAddress returns the EIP-55 checksummed representation of an ethereum address
+func NormalizeEthAddress(s string) string {
+	return gethCommon.HexToAddress(s).Hex()
+}
+
+// EthSignedMessageHash returns the hash an ethereum wallet signs for personal_sign, so that signatures over data can
+// be made with any ethereum key and never collide with the signature of an ethereum transaction
+func EthSignedMessageHash(data []byte) []byte {
+	return crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d", len(data))), data)
+}
+
+// RecoverEthSigner returns the ethereum address whose key made a 65 byte [R || S || V] personal_sign signature over data
+func RecoverEthSigner(data []byte, signature []byte) (gethCommon.Address, error) {
+	if len(signature) != crypto.SignatureLength {
+		return gethCommon.Address{}, errors.New("signature must be 65 bytes long")
+	}
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+	pubKey, err := crypto.SigToPub(EthSignedMessageHash(data), sig)
+	if err != nil {
+		return gethCommon.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubKey), nil
+}
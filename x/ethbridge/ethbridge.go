@@ -1,21 +1,167 @@
 package ethbridge
 
 import (
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/keeper"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/querier"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
 )
 
 type (
-	MsgMakeEthBridgeClaim = types.MsgMakeEthBridgeClaim
+	Keeper = keeper.Keeper
+
+	MsgMakeEthBridgeClaim        = types.MsgMakeEthBridgeClaim
+	MsgMakeEthBridgeClaims       = types.MsgMakeEthBridgeClaims
+	MsgMakeEthBridgeReleaseClaim = types.MsgMakeEthBridgeReleaseClaim
+	MsgBurn                      = types.MsgBurn
+	MsgSetMintingPaused          = types.MsgSetMintingPaused
+	MsgSetMintLimits             = types.MsgSetMintLimits
+	MsgReleaseQueuedMint         = types.MsgReleaseQueuedMint
+	MsgSetReleaseDelay           = types.MsgSetReleaseDelay
+	MsgCancelRelease             = types.MsgCancelRelease
+	MsgSetConsensusTiers         = types.MsgSetConsensusTiers
+	MsgSetBridgeFees             = types.MsgSetBridgeFees
+	MsgSetFeeder                 = types.MsgSetFeeder
+	MsgRegisterEthereumKey       = types.MsgRegisterEthereumKey
+	MsgSignAttestation           = types.MsgSignAttestation
+	MsgMakeEthBridgeProvenClaim  = types.MsgMakeEthBridgeProvenClaim
+	MsgSetTokenScales            = types.MsgSetTokenScales
+	MsgSetTokenDenoms            = types.MsgSetTokenDenoms
+
+	EthBridgeClaim = types.EthBridgeClaim
+	Supply         = types.Supply
+	Params         = types.Params
+	MintLimit      = types.MintLimit
+	MintLimits     = types.MintLimits
+	QueuedMint     = types.QueuedMint
+	PendingRelease = types.PendingRelease
+	ConsensusTier  = types.ConsensusTier
+	ConsensusTiers = types.ConsensusTiers
+	BridgeFee      = types.BridgeFee
+	BridgeFees     = types.BridgeFees
+	FeeReward      = types.FeeReward
+	Feeder         = types.Feeder
+	Feeders        = types.Feeders
+	ClaimResult    = types.ClaimResult
+	ClaimResults   = types.ClaimResults
+	GenesisState   = types.GenesisState
+
+	EthBridgeReleaseClaim = types.EthBridgeReleaseClaim
+	Withdrawal            = types.Withdrawal
+	Withdrawals           = types.Withdrawals
+	DeficitReport         = types.DeficitReport
+	Refund                = types.Refund
+	Refunds               = types.Refunds
+	EthereumKey           = types.EthereumKey
+	EthereumKeys          = types.EthereumKeys
+	OutgoingTransfer      = types.OutgoingTransfer
+	OutgoingTransfers     = types.OutgoingTransfers
+	Valset                = types.Valset
+	Valsets               = types.Valsets
+	ValsetMember          = types.ValsetMember
+	Attestation           = types.Attestation
+	Attestations          = types.Attestations
+	AttestationSignature  = types.AttestationSignature
+	LockLog               = types.LockLog
+	TokenScale            = types.TokenScale
+	TokenScales           = types.TokenScales
+	TokenDenom            = types.TokenDenom
+	TokenDenoms           = types.TokenDenoms
+	Dust                  = types.Dust
+	Dusts                 = types.Dusts
+	PostMintAction        = types.PostMintAction
+	PostMintActions       = types.PostMintActions
+	PostMintMemo          = types.PostMintMemo
+	PostMint              = types.PostMint
+	PostMints             = types.PostMints
 )
 
 var (
+	NewKeeper          = keeper.NewKeeper
+	RegisterInvariants = keeper.RegisterInvariants
+	SupplyInvariant    = keeper.SupplyInvariant
+
 	NewMsgMakeEthBridgeClaim = types.NewMsgMakeEthBridgeClaim
 	NewEthBridgeClaim        = types.NewEthBridgeClaim
+	NewMsgBurn               = types.NewMsgBurn
+	NewMsgSetMintingPaused   = types.NewMsgSetMintingPaused
+	NewMsgSetMintLimits      = types.NewMsgSetMintLimits
+	NewMsgReleaseQueuedMint  = types.NewMsgReleaseQueuedMint
+	NewMsgSetReleaseDelay    = types.NewMsgSetReleaseDelay
+	NewMsgCancelRelease      = types.NewMsgCancelRelease
+	NewMsgSetConsensusTiers  = types.NewMsgSetConsensusTiers
+	NewMsgSetBridgeFees      = types.NewMsgSetBridgeFees
+	NewMsgSetFeeder          = types.NewMsgSetFeeder
+
+	NewMsgMakeEthBridgeClaimFromFeeder = types.NewMsgMakeEthBridgeClaimFromFeeder
+	NewMsgMakeEthBridgeClaims          = types.NewMsgMakeEthBridgeClaims
+	NewMsgMakeEthBridgeReleaseClaim    = types.NewMsgMakeEthBridgeReleaseClaim
+	NewEthBridgeReleaseClaim           = types.NewEthBridgeReleaseClaim
+	NewRefund                          = types.NewRefund
+	NewMsgRegisterEthereumKey          = types.NewMsgRegisterEthereumKey
+	NewEthereumKey                     = types.NewEthereumKey
+	EthereumKeySignBytes               = types.EthereumKeySignBytes
+	NewMsgSignAttestation              = types.NewMsgSignAttestation
+	IsValidAttestationKind             = types.IsValidAttestationKind
+	NewMsgMakeEthBridgeProvenClaim     = types.NewMsgMakeEthBridgeProvenClaim
+	DecodeLockLog                      = types.DecodeLockLog
+	EncodeLockLogData                  = types.EncodeLockLogData
+	NewMsgSetTokenScales               = types.NewMsgSetTokenScales
+	NewTokenScale                      = types.NewTokenScale
+	NewMsgSetTokenDenoms               = types.NewMsgSetTokenDenoms
+	NewTokenDenom                      = types.NewTokenDenom
+	NewDust                            = types.NewDust
+	NewPostMintAction                  = types.NewPostMintAction
+	NewPostMint                        = types.NewPostMint
+	ParseRecipient                     = types.ParseRecipient
+	FormatRecipient                    = types.FormatRecipient
+
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
+	NewMintLimit        = types.NewMintLimit
+	NewConsensusTier    = types.NewConsensusTier
+	NewBridgeFee        = types.NewBridgeFee
+	NewFeeder           = types.NewFeeder
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+
+	NewQueryEthProphecyParams     = types.NewQueryEthProphecyParams
+	NewQueryEthProphecyByTxParams = types.NewQueryEthProphecyByTxParams
+	NewQuerySupplyParams          = types.NewQuerySupplyParams
+	NewQueryFeeRewardsParams      = types.NewQueryFeeRewardsParams
+	NewQueryEthereumKeysParams    = types.NewQueryEthereumKeysParams
+	NewQueryAttestationsParams    = types.NewQueryAttestationsParams
 
-	NewQueryEthProphecyParams = types.NewQueryEthProphecyParams
+	ErrInvalidEthNonce  = types.ErrInvalidEthNonce
+	ErrInvalidItemID    = types.ErrInvalidItemID
+	ErrInvalidEthTxHash = types.ErrInvalidEthTxHash
 
-	ErrInvalidEthNonce = types.ErrInvalidEthNonce
+	ErrInsufficientBridgedSupply = types.ErrInsufficientBridgedSupply
+	ErrUnauthorized              = types.ErrUnauthorized
+	ErrQueuedMintNotFound        = types.ErrQueuedMintNotFound
+	ErrMintingPaused             = types.ErrMintingPaused
+	ErrPendingReleaseNotFound    = types.ErrPendingReleaseNotFound
+	ErrValidatorNotBonded        = types.ErrValidatorNotBonded
+	ErrInvalidFeeder             = types.ErrInvalidFeeder
+	ErrInvalidClaimBatch         = types.ErrInvalidClaimBatch
+	ErrInvalidReleaseKind        = types.ErrInvalidReleaseKind
+
+	ErrInvalidEthereumKeySignature = types.ErrInvalidEthereumKeySignature
+	ErrEthereumKeyInUse            = types.ErrEthereumKeyInUse
+
+	ErrAttestationNotFound           = types.ErrAttestationNotFound
+	ErrEthereumKeyNotRegistered      = types.ErrEthereumKeyNotRegistered
+	ErrDuplicateAttestationSignature = types.ErrDuplicateAttestationSignature
+	ErrInvalidAttestationKind        = types.ErrInvalidAttestationKind
+	ErrNotValsetMember               = types.ErrNotValsetMember
+
+	ErrInvalidLockProof   = types.ErrInvalidLockProof
+	ErrPeggyContractUnset = types.ErrPeggyContractUnset
+	ErrInvalidTokenScale  = types.ErrInvalidTokenScale
+	ErrDustAmount         = types.ErrDustAmount
+	ErrInvalidTokenDenom  = types.ErrInvalidTokenDenom
+
+	ErrInvalidPostMintAction = types.ErrInvalidPostMintAction
 
 	RegisterCodec = types.RegisterCodec
 
@@ -27,6 +173,62 @@ const (
 	QuerierRoute     = types.QuerierRoute
 	RouterKey        = types.RouterKey
 	DefaultCodespace = types.DefaultCodespace
+	ModuleName       = types.ModuleName
+
+	DefaultParamspace = types.DefaultParamspace
+
+	MaxClaimsPerBatch   = types.MaxClaimsPerBatch
+	ClaimRejectedStatus = types.ClaimRejectedStatus
 
-	QueryEthProphecy = querier.QueryEthProphecy
+	ReleaseKindWithdraw = types.ReleaseKindWithdraw
+	ReleaseKindUnlock   = types.ReleaseKindUnlock
+
+	AttestationKindTransfer = types.AttestationKindTransfer
+	AttestationKindValset   = types.AttestationKindValset
+
+	EtherDenom = types.EtherDenom
+
+	EthereumKeyDomain = types.EthereumKeyDomain
+
+	QueryEthProphecy     = querier.QueryEthProphecy
+	QueryEthProphecyByTx = querier.QueryEthProphecyByTx
+	QuerySupply          = querier.QuerySupply
+	QueryParams          = querier.QueryParams
+	QueryQueuedMints     = querier.QueryQueuedMints
+	QueryMintingPaused   = querier.QueryMintingPaused
+	QueryPendingReleases = querier.QueryPendingReleases
+	QueryFeeRewards      = querier.QueryFeeRewards
+	QueryFeeders         = querier.QueryFeeders
+	QueryWithdrawals     = querier.QueryWithdrawals
+	QueryDeficitReport   = querier.QueryDeficitReport
+	QueryRefunds         = querier.QueryRefunds
+	QueryEthereumKeys    = querier.QueryEthereumKeys
+
+	QueryOutgoingTransfers = querier.QueryOutgoingTransfers
+	QueryValsets           = querier.QueryValsets
+	QueryAttestations      = querier.QueryAttestations
+	QueryDust              = querier.QueryDust
+	QueryPostMints         = querier.QueryPostMints
+
+	TagRefundItemID = types.TagRefundItemID
+	TagRefundSender = types.TagRefundSender
+
+	LockEventSignature = types.LockEventSignature
+
+	DustRuleReject    = types.DustRuleReject
+	DustRuleRoundDown = types.DustRuleRoundDown
+
+	MemoSeparator          = types.MemoSeparator
+	PostMintActionSend     = types.PostMintActionSend
+	PostMintActionDelegate = types.PostMintActionDelegate
+	MaxPostMintActions     = types.MaxPostMintActions
+	PostMintStatusPending  = types.PostMintStatusPending
+	PostMintStatusExecuted = types.PostMintStatusExecuted
+	PostMintStatusFailed   = types.PostMintStatusFailed
+)
+
+var (
+	ModuleAddress  = types.ModuleAddress
+	FeePoolAddress = types.FeePoolAddress
+	LockEventTopic = types.LockEventTopic
 )
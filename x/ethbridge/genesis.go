@@ -0,0 +1,74 @@
+package ethbridge
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis sets the ethbridge params, circuit breaker, bridged supplies, queued mints, pending releases, feeders, withdrawals, refunds,
+// ethereum keys, outgoing transfers, valsets, attestations, dust and post-mint actions from the genesis state. The nonces continue from the last transfer and valset.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	keeper.SetMintingPaused(ctx, data.MintingPaused)
+	for _, supply := range data.Supplies {
+		keeper.SetSupply(ctx, supply)
+	}
+	for _, mint := range data.QueuedMints {
+		keeper.SetQueuedMint(ctx, mint)
+	}
+	for _, release := range data.PendingReleases {
+		keeper.SetPendingRelease(ctx, release)
+	}
+	for _, feeder := range data.Feeders {
+		keeper.SetFeeder(ctx, feeder.Validator, feeder.Feeder)
+	}
+	for _, withdrawal := range data.Withdrawals {
+		keeper.SetWithdrawal(ctx, withdrawal)
+	}
+	for _, refund := range data.Refunds {
+		keeper.SetRefund(ctx, refund)
+	}
+	for _, key := range data.EthereumKeys {
+		keeper.SetEthereumKey(ctx, key)
+	}
+	for _, transfer := range data.OutgoingTransfers {
+		keeper.SetOutgoingTransfer(ctx, transfer)
+		if transfer.Nonce > keeper.GetLastNonce(ctx, AttestationKindTransfer) {
+			keeper.SetLastNonce(ctx, AttestationKindTransfer, transfer.Nonce)
+		}
+	}
+	for _, valset := range data.Valsets {
+		keeper.SetValset(ctx, valset)
+		if valset.Nonce > keeper.GetLastNonce(ctx, AttestationKindValset) {
+			keeper.SetLastNonce(ctx, AttestationKindValset, valset.Nonce)
+		}
+	}
+	for _, attestation := range data.Attestations {
+		keeper.SetAttestation(ctx, attestation)
+	}
+	for _, dust := range data.Dusts {
+		keeper.SetDust(ctx, dust)
+	}
+	for _, postMint := range data.PostMints {
+		keeper.SetPostMint(ctx, postMint)
+	}
+}
+
+// ExportGenesis returns the ethbridge state as a genesis state
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return NewGenesisState(
+		keeper.GetParams(ctx),
+		keeper.IsMintingPaused(ctx),
+		keeper.GetSupplies(ctx),
+		keeper.GetQueuedMints(ctx),
+		keeper.GetPendingReleases(ctx),
+		keeper.GetFeeders(ctx),
+		keeper.GetWithdrawals(ctx),
+		keeper.GetRefunds(ctx),
+		keeper.GetEthereumKeys(ctx),
+		keeper.GetOutgoingTransfers(ctx),
+		keeper.GetValsets(ctx),
+		keeper.GetAttestations(ctx, ""),
+		keeper.GetDusts(ctx),
+		keeper.GetPostMints(ctx),
+	)
+}
@@ -5,18 +5,49 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle"
 )
 
 // NewHandler returns a handler for "ethbridge" type messages.
-func NewHandler(oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, cdc *codec.Codec, codespace sdk.CodespaceType) sdk.Handler {
+func NewHandler(keeper Keeper, cdc *codec.Codec, codespace sdk.CodespaceType) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		switch msg := msg.(type) {
 		case MsgMakeEthBridgeClaim:
-			return handleMsgMakeEthBridgeClaim(ctx, cdc, oracleKeeper, bankKeeper, msg, codespace)
+			return handleMsgMakeEthBridgeClaim(ctx, cdc, keeper, msg, codespace)
+		case MsgMakeEthBridgeClaims:
+			return handleMsgMakeEthBridgeClaims(ctx, cdc, keeper, msg, codespace)
+		case MsgMakeEthBridgeProvenClaim:
+			return handleMsgMakeEthBridgeProvenClaim(ctx, keeper, msg, codespace)
+		case MsgMakeEthBridgeReleaseClaim:
+			return handleMsgMakeEthBridgeReleaseClaim(ctx, keeper, msg)
+		case MsgBurn:
+			return handleMsgBurn(ctx, keeper, msg)
+		case MsgSetMintingPaused:
+			return handleMsgSetMintingPaused(ctx, keeper, msg)
+		case MsgSetMintLimits:
+			return handleMsgSetMintLimits(ctx, keeper, msg)
+		case MsgReleaseQueuedMint:
+			return handleMsgReleaseQueuedMint(ctx, keeper, msg)
+		case MsgSetReleaseDelay:
+			return handleMsgSetReleaseDelay(ctx, keeper, msg)
+		case MsgCancelRelease:
+			return handleMsgCancelRelease(ctx, keeper, msg)
+		case MsgSetConsensusTiers:
+			return handleMsgSetConsensusTiers(ctx, keeper, msg)
+		case MsgSetBridgeFees:
+			return handleMsgSetBridgeFees(ctx, keeper, msg)
+		case MsgSetFeeder:
+			return handleMsgSetFeeder(ctx, keeper, msg)
+		case MsgRegisterEthereumKey:
+			return handleMsgRegisterEthereumKey(ctx, keeper, msg)
+		case MsgSignAttestation:
+			return handleMsgSignAttestation(ctx, keeper, msg)
+		case MsgSetTokenScales:
+			return handleMsgSetTokenScales(ctx, keeper, msg)
+		case MsgSetTokenDenoms:
+			return handleMsgSetTokenDenoms(ctx, keeper, msg)
 		default:
 			errMsg := fmt.Sprintf("Unrecognized ethbridge message type: %v", msg.Type())
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -25,23 +56,101 @@ func NewHandler(oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, cdc *codec.C
 }
 
 // Handle a message to make a bridge claim
-func handleMsgMakeEthBridgeClaim(ctx sdk.Context, cdc *codec.Codec, oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, msg MsgMakeEthBridgeClaim, codespace sdk.CodespaceType) sdk.Result {
-	if msg.CosmosReceiver.Empty() {
-		return sdk.ErrInvalidAddress(msg.CosmosReceiver.String()).Result()
+func handleMsgMakeEthBridgeClaim(ctx sdk.Context, cdc *codec.Codec, keeper Keeper, msg MsgMakeEthBridgeClaim, codespace sdk.CodespaceType) sdk.Result {
+	status, tags, err := processEthBridgeClaim(ctx, keeper, msg.EthBridgeClaim, msg.Feeder, codespace)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Log: status, Tags: tags}
+}
+
+// Handle a message to make a bridge claim backed by a receipt proof. The claim is only forwarded to the oracle once
+// the proof shows the claimed LogLock log in a confirmed ethereum block.
+func handleMsgMakeEthBridgeProvenClaim(ctx sdk.Context, keeper Keeper, msg MsgMakeEthBridgeProvenClaim, codespace sdk.CodespaceType) sdk.Result {
+	if err := keeper.VerifyLockProof(ctx, msg.EthBridgeClaim, msg.ReceiptProof, msg.ReceiptLogIndex); err != nil {
+		return err.Result()
 	}
-	if msg.Nonce < 0 {
-		return types.ErrInvalidEthNonce(codespace).Result()
+	status, tags, err := processEthBridgeClaim(ctx, keeper, msg.EthBridgeClaim, msg.Feeder, codespace)
+	if err != nil {
+		return err.Result()
 	}
-	if !common.IsValidEthAddress(msg.EthereumSender) {
-		return types.ErrInvalidEthAddress(codespace).Result()
+	return sdk.Result{Log: status, Tags: tags}
+}
+
+// Handle a message to make a batch of bridge claims. Each claim runs in its own cache context that is only written
+// when the claim succeeds, so a failed claim leaves no state behind and does not abort the rest of the batch.
+func handleMsgMakeEthBridgeClaims(ctx sdk.Context, cdc *codec.Codec, keeper Keeper, msg MsgMakeEthBridgeClaims, codespace sdk.CodespaceType) sdk.Result {
+	results := make(types.ClaimResults, len(msg.EthBridgeClaims))
+	var tags sdk.Tags
+	for i, claim := range msg.EthBridgeClaims {
+		claimCtx, writeCache := ctx.CacheContext()
+		status, claimTags, err := processEthBridgeClaim(claimCtx, keeper, claim, msg.Feeder, codespace)
+		if err != nil {
+			results[i] = types.NewRejectedClaimResult(claim.ItemID, err)
+			continue
+		}
+		writeCache()
+		results[i] = types.NewClaimResult(claim.ItemID, status)
+		tags = tags.AppendTags(claimTags)
 	}
-	oracleId, validator, claimString := types.CreateOracleClaimFromEthClaim(cdc, msg.EthBridgeClaim)
-	status, err := oracleKeeper.ProcessClaim(ctx, oracleId, validator, claimString)
+	return sdk.Result{Data: cdc.MustMarshalJSON(results), Log: results.String(), Tags: tags}
+}
+
+// processEthBridgeClaim checks a claim, forwards it to the oracle and mints its coins once it succeeds, or places
+// the item in the refund queue once it fails. It returns the status of the prophecy and the tags of a refund.
+func processEthBridgeClaim(ctx sdk.Context, keeper Keeper, claim types.EthBridgeClaim, feeder sdk.AccAddress, codespace sdk.CodespaceType) (string, sdk.Tags, sdk.Error) {
+	if claim.CosmosReceiver.Empty() {
+		return "", nil, sdk.ErrInvalidAddress(claim.CosmosReceiver.String())
+	}
+	if claim.Nonce < 0 {
+		return "", nil, types.ErrInvalidEthNonce(codespace)
+	}
+	if !common.IsValidEthAddress(claim.EthereumSender) {
+		return "", nil, types.ErrInvalidEthAddress(codespace)
+	}
+	if !common.IsValidEthHash(claim.ItemID) {
+		return "", nil, types.ErrInvalidItemID(codespace)
+	}
+	if !common.IsValidEthHash(claim.EthereumTxHash) {
+		return "", nil, types.ErrInvalidEthTxHash(codespace)
+	}
+	if !claim.Amount.IsValid() || !claim.Amount.IsAllPositive() {
+		return "", nil, sdk.ErrInvalidCoins(claim.Amount.String())
+	}
+	if err := keeper.ValidateClaimSigner(ctx, claim.Validator, feeder); err != nil {
+		return "", nil, err
+	}
+	status, err := keeper.ProcessClaim(ctx, claim)
+	if err != nil {
+		return "", nil, err
+	}
+	switch status.StatusText {
+	case oracle.SuccessStatus:
+		err = keeper.ProcessSuccessfulClaim(ctx, claim.ItemID, status.FinalClaim)
+		if err != nil {
+			return "", nil, err
+		}
+	case oracle.FailedStatus:
+		refund, err := keeper.ProcessFailedClaim(ctx, claim.ItemID)
+		if err != nil {
+			return "", nil, err
+		}
+		return status.StatusText, refund.Tags(), nil
+	}
+	return status.StatusText, nil, nil
+}
+
+// Handle a message to claim that the locked funds of a peggy item were released on ethereum
+func handleMsgMakeEthBridgeReleaseClaim(ctx sdk.Context, keeper Keeper, msg MsgMakeEthBridgeReleaseClaim) sdk.Result {
+	if err := keeper.ValidateClaimSigner(ctx, msg.Validator, msg.Feeder); err != nil {
+		return err.Result()
+	}
+	status, err := keeper.ProcessReleaseClaim(ctx, msg.EthBridgeReleaseClaim)
 	if err != nil {
 		return err.Result()
 	}
 	if status.StatusText == oracle.SuccessStatus {
-		err = processSuccessfulClaim(ctx, bankKeeper, status.FinalClaim)
+		err = keeper.ProcessSuccessfulReleaseClaim(ctx, msg.ItemID, status.FinalClaim)
 		if err != nil {
 			return err.Result()
 		}
@@ -49,15 +158,164 @@ func handleMsgMakeEthBridgeClaim(ctx sdk.Context, cdc *codec.Codec, oracleKeeper
 	return sdk.Result{Log: status.StatusText}
 }
 
-func processSuccessfulClaim(ctx sdk.Context, bankKeeper bank.Keeper, claim string) sdk.Error {
-	oracleClaim, err := types.CreateOracleClaimFromOracleString(claim)
+// Handle a message to burn bridged coins
+func handleMsgBurn(ctx sdk.Context, keeper Keeper, msg MsgBurn) sdk.Result {
+	err := keeper.BurnCoins(ctx, msg.Sender, msg.Amount)
 	if err != nil {
-		return err
+		return err.Result()
+	}
+	transfers := keeper.AddOutgoingTransfers(ctx, msg.Sender, msg.EthereumReceiver, msg.Amount)
+	return sdk.Result{Log: transfers.String()}
+}
+
+// Handle a message to trip or reset the circuit breaker
+func handleMsgSetMintingPaused(ctx sdk.Context, keeper Keeper, msg MsgSetMintingPaused) sdk.Result {
+	if !keeper.IsAdmin(ctx, msg.Admin) {
+		return types.ErrUnauthorized(keeper.Codespace()).Result()
+	}
+	keeper.SetMintingPaused(ctx, msg.Paused)
+	return sdk.Result{}
+}
+
+// Handle a message to change the mint window and mint limits
+func handleMsgSetMintLimits(ctx sdk.Context, keeper Keeper, msg MsgSetMintLimits) sdk.Result {
+	if !keeper.IsAdmin(ctx, msg.Admin) {
+		return types.ErrUnauthorized(keeper.Codespace()).Result()
+	}
+	params := keeper.GetParams(ctx)
+	params.MintWindow = msg.MintWindow
+	params.MintLimits = msg.MintLimits
+	if err := params.Validate(); err != nil {
+		return types.ErrInvalidParams(keeper.Codespace(), err.Error()).Result()
 	}
-	receiverAddress := oracleClaim.CosmosReceiver
-	_, _, err = bankKeeper.AddCoins(ctx, receiverAddress, oracleClaim.Amount)
+	keeper.SetParams(ctx, params)
+	return sdk.Result{}
+}
+
+// Handle a message to mint a queued claim
+func handleMsgReleaseQueuedMint(ctx sdk.Context, keeper Keeper, msg MsgReleaseQueuedMint) sdk.Result {
+	if !keeper.IsAdmin(ctx, msg.Admin) {
+		return types.ErrUnauthorized(keeper.Codespace()).Result()
+	}
+	_, err := keeper.ReleaseQueuedMint(ctx, common.NormalizeEthHash(msg.ItemID))
 	if err != nil {
-		return err
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle a message to change the release delay and delay thresholds
+func handleMsgSetReleaseDelay(ctx sdk.Context, keeper Keeper, msg MsgSetReleaseDelay) sdk.Result {
+	if !keeper.IsAdmin(ctx, msg.Admin) {
+		return types.ErrUnauthorized(keeper.Codespace()).Result()
+	}
+	params := keeper.GetParams(ctx)
+	params.ReleaseDelay = msg.ReleaseDelay
+	params.DelayThresholds = msg.DelayThresholds
+	if err := params.Validate(); err != nil {
+		return types.ErrInvalidParams(keeper.Codespace(), err.Error()).Result()
+	}
+	keeper.SetParams(ctx, params)
+	return sdk.Result{}
+}
+
+// Handle a message to cancel a pending release
+func handleMsgCancelRelease(ctx sdk.Context, keeper Keeper, msg MsgCancelRelease) sdk.Result {
+	if !keeper.IsAdmin(ctx, msg.Signer) && !keeper.IsGuardian(ctx, msg.Signer) {
+		return types.ErrUnauthorized(keeper.Codespace()).Result()
+	}
+	_, err := keeper.CancelRelease(ctx, common.NormalizeEthHash(msg.ItemID))
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle a message to change the consensus tiers
+func handleMsgSetConsensusTiers(ctx sdk.Context, keeper Keeper, msg MsgSetConsensusTiers) sdk.Result {
+	if !keeper.IsAdmin(ctx, msg.Admin) {
+		return types.ErrUnauthorized(keeper.Codespace()).Result()
+	}
+	params := keeper.GetParams(ctx)
+	params.ConsensusTiers = msg.ConsensusTiers
+	if err := params.Validate(); err != nil {
+		return types.ErrInvalidParams(keeper.Codespace(), err.Error()).Result()
+	}
+	keeper.SetParams(ctx, params)
+	return sdk.Result{}
+}
+
+// Handle a message to change the bridge fees
+func handleMsgSetBridgeFees(ctx sdk.Context, keeper Keeper, msg MsgSetBridgeFees) sdk.Result {
+	if !keeper.IsAdmin(ctx, msg.Admin) {
+		return types.ErrUnauthorized(keeper.Codespace()).Result()
+	}
+	params := keeper.GetParams(ctx)
+	params.BridgeFees = msg.BridgeFees
+	if err := params.Validate(); err != nil {
+		return types.ErrInvalidParams(keeper.Codespace(), err.Error()).Result()
+	}
+	keeper.SetParams(ctx, params)
+	return sdk.Result{}
+}
+
+// Handle a message to change the decimal scaling of bridged tokens
+func handleMsgSetTokenScales(ctx sdk.Context, keeper Keeper, msg MsgSetTokenScales) sdk.Result {
+	if !keeper.IsAdmin(ctx, msg.Admin) {
+		return types.ErrUnauthorized(keeper.Codespace()).Result()
+	}
+	params := keeper.GetParams(ctx)
+	params.TokenScales = msg.TokenScales
+	if err := params.Validate(); err != nil {
+		return types.ErrInvalidParams(keeper.Codespace(), err.Error()).Result()
+	}
+	keeper.SetParams(ctx, params)
+	return sdk.Result{}
+}
+
+// Handle a message to change the ERC20 tokens that can be bridged
+func handleMsgSetTokenDenoms(ctx sdk.Context, keeper Keeper, msg MsgSetTokenDenoms) sdk.Result {
+	if !keeper.IsAdmin(ctx, msg.Admin) {
+		return types.ErrUnauthorized(keeper.Codespace()).Result()
+	}
+	params := keeper.GetParams(ctx)
+	params.TokenDenoms = msg.TokenDenoms
+	if err := params.Validate(); err != nil {
+		return types.ErrInvalidParams(keeper.Codespace(), err.Error()).Result()
+	}
+	keeper.SetParams(ctx, params)
+	return sdk.Result{}
+}
+
+// Handle a message to register the feeder of a validator
+func handleMsgSetFeeder(ctx sdk.Context, keeper Keeper, msg MsgSetFeeder) sdk.Result {
+	err := keeper.RegisterFeeder(ctx, msg.Validator, msg.Feeder)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle a message to register or rotate the ethereum key of a validator
+func handleMsgRegisterEthereumKey(ctx sdk.Context, keeper Keeper, msg MsgRegisterEthereumKey) sdk.Result {
+	if err := msg.VerifySignature(ctx.ChainID()); err != nil {
+		return err.Result()
+	}
+	err := keeper.RegisterEthereumKey(ctx, msg.Validator, msg.EthereumAddress)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{}
+}
+
+// Handle a message to sign the checkpoint of an outgoing transfer or valset with a validator's ethereum key
+func handleMsgSignAttestation(ctx sdk.Context, keeper Keeper, msg MsgSignAttestation) sdk.Result {
+	if err := keeper.ValidateClaimSigner(ctx, sdk.AccAddress(msg.Validator), msg.Feeder); err != nil {
+		return err.Result()
+	}
+	attestation, err := keeper.SignAttestation(ctx, msg.Kind, msg.Nonce, msg.Validator, msg.Signature)
+	if err != nil {
+		return err.Result()
 	}
-	return nil
+	return sdk.Result{Log: fmt.Sprintf("complete: %t", attestation.Complete)}
 }
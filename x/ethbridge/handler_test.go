@@ -8,19 +8,20 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
 
-	"github.com/stretchr/testify/require"
+	keeperLib "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/keeper"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
-	keeperLib "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/keeper"
+	"github.com/stretchr/testify/require"
 )
 
 func TestBasicMsgs(t *testing.T) {
 	//Setup
 	cdc := codec.New()
-	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	ctx, _, keeper, _, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
 	accAddress := sdk.AccAddress(validatorAddresses[0])
 
-	handler := NewHandler(keeper, bankKeeper, cdc, types.DefaultCodespace)
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
 
 	//Unrecognized type
 	res := handler(ctx, sdk.NewTestMsg())
@@ -44,14 +45,26 @@ func TestBasicMsgs(t *testing.T) {
 	res = handler(ctx, badCreateMsg)
 	require.False(t, res.IsOK())
 	require.True(t, strings.Contains(res.Log, "invalid ethereum address provided"))
+
+	badCreateMsg = types.CreateTestEthMsg(t, accAddress)
+	badCreateMsg.ItemID = "0x1234"
+	res = handler(ctx, badCreateMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "invalid peggy item id provided"))
+
+	badCreateMsg = types.CreateTestEthMsg(t, accAddress)
+	badCreateMsg.EthereumTxHash = "badTxHash"
+	res = handler(ctx, badCreateMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "invalid ethereum transaction hash provided"))
 }
 
 func TestDuplicateMsgs(t *testing.T) {
 	cdc := codec.New()
-	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	ctx, _, keeper, _, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
 	accAddress := sdk.AccAddress(validatorAddresses[0])
 
-	handler := NewHandler(keeper, bankKeeper, cdc, types.DefaultCodespace)
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
 	normalCreateMsg := types.CreateTestEthMsg(t, accAddress)
 	res := handler(ctx, normalCreateMsg)
 	require.True(t, res.IsOK())
@@ -72,7 +85,7 @@ func TestMintSuccess(t *testing.T) {
 	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
 	accAddressVal3Pow1 := sdk.AccAddress(validatorAddresses[2])
 
-	handler := NewHandler(keeper, bankKeeper, cdc, types.DefaultCodespace)
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
 
 	//Initial message
 	normalCreateMsg := types.CreateTestEthMsg(t, accAddressVal1Pow2)
@@ -90,6 +103,7 @@ func TestMintSuccess(t *testing.T) {
 	require.NoError(t, err)
 	require.True(t, receiverCoins.IsEqual(expectedCoins))
 	require.Equal(t, res.Log, oracle.SuccessStatus)
+	require.Equal(t, expectedCoins.AmountOf("ethereum"), keeper.GetSupply(ctx, "ethereum").Outstanding)
 
 	//Additional message from third validator fails and does not mint
 	normalCreateMsg = types.CreateTestEthMsg(t, accAddressVal3Pow1)
@@ -106,7 +120,7 @@ func TestMintSuccess(t *testing.T) {
 func TestNoMintFail(t *testing.T) {
 	//Setup
 	cdc := codec.New()
-	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 4, 3})
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.6, []int64{3, 4, 3})
 	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
 	accAddressVal2Pow4 := sdk.AccAddress(validatorAddresses[1])
 	accAddressVal3Pow3 := sdk.AccAddress(validatorAddresses[2])
@@ -118,7 +132,7 @@ func TestNoMintFail(t *testing.T) {
 	ethClaim3 := types.CreateTestEthClaim(t, accAddressVal3Pow3, types.TestEthereumAddress, types.AltTestCoins)
 	ethMsg3 := NewMsgMakeEthBridgeClaim(ethClaim3)
 
-	handler := NewHandler(keeper, bankKeeper, cdc, types.DefaultCodespace)
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
 
 	//Initial message
 	res := handler(ctx, ethMsg1)
@@ -141,3 +155,556 @@ func TestNoMintFail(t *testing.T) {
 	receiver1Coins := bankKeeper.GetCoins(ctx, receiverAddress)
 	require.True(t, receiver1Coins.IsZero())
 }
+
+func TestNoMintDifferentEvidence(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{4, 6})
+	accAddressVal1Pow4 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow6 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	//Initial message
+	res := handler(ctx, types.CreateTestEthMsg(t, accAddressVal1Pow4))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+
+	//Same item claimed from a different log does not count towards the first claim
+	altEvidenceMsg := types.CreateTestEthMsg(t, accAddressVal2Pow6)
+	altEvidenceMsg.EthereumLogIndex = types.TestEthereumLogIndex + 1
+	res = handler(ctx, altEvidenceMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.FailedStatus)
+
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+}
+
+func TestBurn(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	//Mint coins through a successful prophecy
+	res := handler(ctx, types.CreateTestEthMsg(t, sdk.AccAddress(validatorAddresses[1])))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+
+	//Burning more than the receiver holds fails
+	res = handler(ctx, NewMsgBurn(receiverAddress, types.TestEthereumAddress, sdk.NewCoins(sdk.NewInt64Coin("ethereum", 11))))
+	require.False(t, res.IsOK())
+
+	//Normal burn
+	res = handler(ctx, NewMsgBurn(receiverAddress, types.TestEthereumAddress, sdk.NewCoins(sdk.NewInt64Coin("ethereum", 10))))
+	require.True(t, res.IsOK())
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+
+	supply := keeper.GetSupply(ctx, "ethereum")
+	require.Equal(t, sdk.NewInt(10), supply.Burned)
+	require.True(t, supply.Outstanding.IsZero())
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	admin := sdk.AccAddress(validatorAddresses[0])
+	params := keeper.GetParams(ctx)
+	params.Admin = admin
+	keeper.SetParams(ctx, params)
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	//Only the admin can pause minting
+	res := handler(ctx, NewMsgSetMintingPaused(sdk.AccAddress(validatorAddresses[1]), true))
+	require.False(t, res.IsOK())
+	res = handler(ctx, NewMsgSetMintingPaused(admin, true))
+	require.True(t, res.IsOK())
+
+	//A successful claim finalizes but its coins are queued
+	res = handler(ctx, types.CreateTestEthMsg(t, sdk.AccAddress(validatorAddresses[1])))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+	require.Len(t, keeper.GetQueuedMints(ctx), 1)
+
+	//Resume minting and release the queued claim
+	res = handler(ctx, NewMsgSetMintingPaused(admin, false))
+	require.True(t, res.IsOK())
+	res = handler(ctx, NewMsgReleaseQueuedMint(sdk.AccAddress(validatorAddresses[1]), types.TestItemID))
+	require.False(t, res.IsOK())
+	res = handler(ctx, NewMsgReleaseQueuedMint(admin, types.TestItemID))
+	require.True(t, res.IsOK())
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 10))))
+	require.Len(t, keeper.GetQueuedMints(ctx), 0)
+
+	//Only the admin can change the mint limits
+	limits := MintLimits{NewMintLimit("ethereum", sdk.NewInt(100), sdk.ZeroInt())}
+	res = handler(ctx, NewMsgSetMintLimits(sdk.AccAddress(validatorAddresses[1]), 50, limits))
+	require.False(t, res.IsOK())
+	res = handler(ctx, NewMsgSetMintLimits(admin, 50, limits))
+	require.True(t, res.IsOK())
+	require.Equal(t, int64(50), keeper.GetParams(ctx).MintWindow)
+	require.Equal(t, limits, keeper.GetParams(ctx).MintLimits)
+
+	//Params that do not validate are not stored
+	res = handler(ctx, NewMsgSetMintLimits(admin, 0, limits))
+	require.Equal(t, types.CodeInvalidParams, res.Code)
+	require.Equal(t, int64(50), keeper.GetParams(ctx).MintWindow)
+}
+
+func TestCancelReleaseByGuardian(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	admin := sdk.AccAddress(validatorAddresses[0])
+	guardian := sdk.AccAddress(validatorAddresses[1])
+	params := keeper.GetParams(ctx)
+	params.Admin = admin
+	params.Guardian = guardian
+	keeper.SetParams(ctx, params)
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	//Only the admin can set the release delay
+	res := handler(ctx, NewMsgSetReleaseDelay(guardian, 10, sdk.NewCoins(sdk.NewInt64Coin("ethereum", 5))))
+	require.False(t, res.IsOK())
+	res = handler(ctx, NewMsgSetReleaseDelay(admin, 10, sdk.NewCoins(sdk.NewInt64Coin("ethereum", 5))))
+	require.True(t, res.IsOK())
+
+	//A successful claim above the threshold finalizes but is not minted
+	res = handler(ctx, types.CreateTestEthMsg(t, guardian))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+	require.Len(t, keeper.GetPendingReleases(ctx), 1)
+
+	//The guardian cancels the release
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	res = handler(ctx, NewMsgCancelRelease(receiverAddress, types.TestItemID))
+	require.False(t, res.IsOK())
+	res = handler(ctx, NewMsgCancelRelease(guardian, types.TestItemID))
+	require.True(t, res.IsOK())
+	require.Len(t, keeper.GetPendingReleases(ctx), 0)
+
+	EndBlocker(ctx.WithBlockHeight(20), keeper)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+}
+
+func TestConsensusTiers(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{2, 8})
+	accAddressVal1Pow2 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow8 := sdk.AccAddress(validatorAddresses[1])
+	admin := accAddressVal1Pow2
+	params := keeper.GetParams(ctx)
+	params.Admin = admin
+	keeper.SetParams(ctx, params)
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	//Claims above 5ethereum need 90% consensus
+	tiers := ConsensusTiers{NewConsensusTier(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 5)), sdk.NewDecWithPrec(9, 1))}
+	res := handler(ctx, NewMsgSetConsensusTiers(accAddressVal2Pow8, tiers))
+	require.False(t, res.IsOK())
+	res = handler(ctx, NewMsgSetConsensusTiers(admin, tiers))
+	require.True(t, res.IsOK())
+
+	//80% is not enough for a claim of 10ethereum
+	res = handler(ctx, types.CreateTestEthMsg(t, accAddressVal2Pow8))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+
+	prophecy, err := keeper.GetProphecy(ctx, types.TestItemID)
+	require.NoError(t, err)
+	require.True(t, keeper.GetConsensusProgress(ctx, prophecy).ConsensusNeeded.Equal(sdk.NewDecWithPrec(9, 1)))
+
+	res = handler(ctx, types.CreateTestEthMsg(t, accAddressVal1Pow2))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+
+	receiverAddress, addrErr := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, addrErr)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 10))))
+}
+
+func TestBridgeFeeDistribution(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.6, []int64{3, 3, 4})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow3 := sdk.AccAddress(validatorAddresses[1])
+	accAddressVal3Pow4 := sdk.AccAddress(validatorAddresses[2])
+	params := keeper.GetParams(ctx)
+	params.Admin = accAddressVal3Pow4
+	keeper.SetParams(ctx, params)
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	//A fee of 2 plus 10% of the minted amount
+	fees := BridgeFees{NewBridgeFee("ethereum", sdk.NewDecWithPrec(1, 1), sdk.NewInt(2))}
+	res := handler(ctx, NewMsgSetBridgeFees(accAddressVal1Pow3, fees))
+	require.False(t, res.IsOK())
+	res = handler(ctx, NewMsgSetBridgeFees(accAddressVal3Pow4, fees))
+	require.True(t, res.IsOK())
+
+	//A dissenting claim is not rewarded
+	dissentingMsg := types.CreateTestEthMsg(t, accAddressVal3Pow4)
+	dissentingMsg.Amount = sdk.NewCoins(sdk.NewInt64Coin("ethereum", 12))
+	res = handler(ctx, dissentingMsg)
+	require.True(t, res.IsOK())
+	res = handler(ctx, types.CreateTestEthMsg(t, accAddressVal1Pow3))
+	require.True(t, res.IsOK())
+	res = handler(ctx, types.CreateTestEthMsg(t, accAddressVal2Pow3))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+
+	//The receiver gets 10 less the fee of 3, which is split between the two matching validators
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 7))))
+	require.Equal(t, int64(1), bankKeeper.GetCoins(ctx, accAddressVal1Pow3).AmountOf("ethereum").Int64())
+	require.Equal(t, int64(1), bankKeeper.GetCoins(ctx, accAddressVal2Pow3).AmountOf("ethereum").Int64())
+	require.True(t, bankKeeper.GetCoins(ctx, accAddressVal3Pow4).AmountOf("ethereum").IsZero())
+	require.Equal(t, int64(1), bankKeeper.GetCoins(ctx, FeePoolAddress).AmountOf("ethereum").Int64())
+
+	rewards := keeper.GetFeeRewards(ctx, validatorAddresses[0])
+	require.Len(t, rewards, 1)
+	require.Equal(t, types.TestItemID, rewards[0].ItemID)
+	require.Len(t, keeper.GetFeeRewards(ctx, validatorAddresses[2]), 0)
+	require.Len(t, keeper.GetAllFeeRewards(ctx), 2)
+}
+
+func TestFeederClaims(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, _, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddress := sdk.AccAddress(validatorAddresses[0])
+	feeder := sdk.AccAddress(crypto.AddressHash([]byte("feeder")))
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	//Claims can only be made for bonded validators
+	res := handler(ctx, types.CreateTestEthMsg(t, feeder))
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "claims can only be made for bonded validators"))
+
+	//A feeder must be registered by the validator before it can sign its claims
+	feederMsg := NewMsgMakeEthBridgeClaimFromFeeder(types.CreateTestEthMsg(t, accAddress).EthBridgeClaim, feeder)
+	require.Equal(t, []sdk.AccAddress{feeder}, feederMsg.GetSigners())
+	res = handler(ctx, feederMsg)
+	require.False(t, res.IsOK())
+	require.True(t, strings.Contains(res.Log, "signer is not the registered feeder of the validator"))
+
+	res = handler(ctx, NewMsgSetFeeder(sdk.ValAddress(feeder), feeder))
+	require.False(t, res.IsOK())
+	res = handler(ctx, NewMsgSetFeeder(validatorAddresses[0], feeder))
+	require.True(t, res.IsOK())
+	require.Equal(t, Feeders{NewFeeder(validatorAddresses[0], feeder)}, keeper.GetFeeders(ctx))
+
+	res = handler(ctx, feederMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+
+	//Removing the feeder revokes its right to sign claims
+	res = handler(ctx, NewMsgSetFeeder(validatorAddresses[0], nil))
+	require.True(t, res.IsOK())
+	require.Len(t, keeper.GetFeeders(ctx), 0)
+	err := keeper.ValidateClaimSigner(ctx, accAddress, feeder)
+	require.Error(t, err)
+	require.NoError(t, keeper.ValidateClaimSigner(ctx, accAddress, nil))
+}
+
+func TestBatchedClaims(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	//Claims of different validators cannot be batched
+	claim := types.CreateTestEthClaim(t, accAddressVal1Pow3, types.TestEthereumAddress, types.TestCoins)
+	otherValidatorClaim := types.CreateTestEthClaim(t, accAddressVal2Pow7, types.TestEthereumAddress, types.TestCoins)
+	require.Error(t, NewMsgMakeEthBridgeClaims([]EthBridgeClaim{claim, otherValidatorClaim}, nil).ValidateBasic())
+	require.Error(t, NewMsgMakeEthBridgeClaims([]EthBridgeClaim{}, nil).ValidateBasic())
+
+	//A rejected claim does not abort the rest of the batch
+	duplicateClaim := claim
+	invalidClaim := types.CreateTestEthClaim(t, accAddressVal1Pow3, types.TestEthereumAddress, types.TestCoins)
+	invalidClaim.ItemID = types.AltTestItemID
+	invalidClaim.Nonce = -1
+	res := handler(ctx, NewMsgMakeEthBridgeClaims([]EthBridgeClaim{claim, duplicateClaim, invalidClaim}, nil))
+	require.True(t, res.IsOK())
+
+	var results ClaimResults
+	cdc.MustUnmarshalJSON(res.Data, &results)
+	require.Len(t, results, 3)
+	require.Equal(t, oracle.PendingStatus, results[0].Status)
+	require.Equal(t, ClaimRejectedStatus, results[1].Status)
+	require.True(t, strings.Contains(results[1].Log, "Already processed message from validator for this id"))
+	require.Equal(t, ClaimRejectedStatus, results[2].Status)
+	require.Equal(t, types.CodeInvalidEthNonce, results[2].Code)
+
+	//The rejected claim left no prophecy behind
+	_, err := keeper.GetProphecy(ctx, types.AltTestItemID)
+	require.Error(t, err)
+
+	//The second validator's batch completes the prophecy
+	res = handler(ctx, NewMsgMakeEthBridgeClaims([]EthBridgeClaim{otherValidatorClaim}, nil))
+	require.True(t, res.IsOK())
+	cdc.MustUnmarshalJSON(res.Data, &results)
+	require.Equal(t, oracle.SuccessStatus, results[0].Status)
+	receiverAddress, addrErr := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, addrErr)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 10))))
+}
+
+func TestMalformedClaimAmount(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, _, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	params := keeper.GetParams(ctx)
+	params.TokenScales = types.TokenScales{types.NewTokenScale("ethereum", "ueth", 18, 6, types.DustRuleRoundDown)}
+	keeper.SetParams(ctx, params)
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	//Negative, zero, duplicate and unsorted amounts are rejected without aborting the rest of the batch
+	claim := types.CreateTestEthClaim(t, accAddressVal1Pow3, types.TestEthereumAddress, "3000000000000ethereum")
+	malformed := []sdk.Coins{
+		{sdk.Coin{Denom: "ethereum", Amount: sdk.NewInt(-5)}},
+		{sdk.Coin{Denom: "ethereum", Amount: sdk.ZeroInt()}},
+		{sdk.NewInt64Coin("ethereum", 5), sdk.NewInt64Coin("ethereum", 5)},
+		{sdk.NewInt64Coin("stake", 5), sdk.NewInt64Coin("ethereum", 5)},
+		{},
+	}
+	claims := []EthBridgeClaim{claim}
+	for _, amount := range malformed {
+		malformedClaim := types.CreateTestEthClaim(t, accAddressVal1Pow3, types.TestEthereumAddress, types.TestCoins)
+		malformedClaim.ItemID = types.AltTestItemID
+		malformedClaim.Amount = amount
+		require.Equal(t, sdk.CodeInvalidCoins, NewMsgMakeEthBridgeClaim(malformedClaim).ValidateBasic().Code())
+		claims = append(claims, malformedClaim)
+	}
+	require.Error(t, NewMsgMakeEthBridgeClaims(claims, nil).ValidateBasic())
+
+	res := handler(ctx, NewMsgMakeEthBridgeClaims(claims, nil))
+	require.True(t, res.IsOK())
+	var results ClaimResults
+	cdc.MustUnmarshalJSON(res.Data, &results)
+	require.Len(t, results, len(claims))
+	require.Equal(t, oracle.PendingStatus, results[0].Status)
+	for _, result := range results[1:] {
+		require.Equal(t, ClaimRejectedStatus, result.Status)
+		require.Equal(t, sdk.CodeInvalidCoins, result.Code)
+	}
+	_, err := keeper.GetProphecy(ctx, types.AltTestItemID)
+	require.Error(t, err)
+
+	//A single malformed claim is rejected by the handler too
+	res = handler(ctx, types.NewMsgMakeEthBridgeClaim(claims[1]))
+	require.Equal(t, sdk.CodeInvalidCoins, res.Code)
+}
+
+func TestWithdrawalClawback(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddressVal1Pow3 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow7 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	res := handler(ctx, types.CreateTestEthMsg(t, accAddressVal1Pow3))
+	require.True(t, res.IsOK())
+	res = handler(ctx, types.CreateTestEthMsg(t, accAddressVal2Pow7))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+
+	//The receiver spends part of the minted coins before the sender withdraws the locked funds on ethereum
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	_, err = bankKeeper.SendCoins(ctx, receiverAddress, accAddressVal1Pow3, sdk.NewCoins(sdk.NewInt64Coin("ethereum", 4)))
+	require.NoError(t, err)
+
+	amount, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+	releaseClaim := NewEthBridgeReleaseClaim(types.TestItemID, types.TestEthereumTxHash, 0, ReleaseKindWithdraw,
+		types.TestEthereumAddress, accAddressVal1Pow3, amount)
+	badKindClaim := releaseClaim
+	badKindClaim.Kind = "steal"
+	require.Error(t, NewMsgMakeEthBridgeReleaseClaim(badKindClaim, nil).ValidateBasic())
+
+	res = handler(ctx, NewMsgMakeEthBridgeReleaseClaim(releaseClaim, nil))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+	require.Len(t, keeper.GetWithdrawals(ctx), 0)
+
+	releaseClaim.Validator = accAddressVal2Pow7
+	res = handler(ctx, NewMsgMakeEthBridgeReleaseClaim(releaseClaim, nil))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+
+	//What is left of the minted coins is clawed back and burned, and the rest is a deficit
+	withdrawal, found := keeper.GetWithdrawal(ctx, types.TestItemID)
+	require.True(t, found)
+	require.True(t, withdrawal.ClawedBack.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 6))))
+	require.True(t, withdrawal.Deficit.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 4))))
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsZero())
+	require.Equal(t, int64(4), keeper.GetSupply(ctx, "ethereum").Outstanding.Int64())
+	require.NoError(t, SupplyInvariant(keeper)(ctx))
+
+	report := keeper.GetDeficitReport(ctx)
+	require.True(t, report.Deficit.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 4))))
+	require.Len(t, report.Withdrawals, 1)
+}
+
+func TestFailedClaimRefund(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, _, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{4, 6})
+	accAddressVal1Pow4 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow6 := sdk.AccAddress(validatorAddresses[1])
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	res := handler(ctx, types.CreateTestEthMsg(t, accAddressVal1Pow4))
+	require.True(t, res.IsOK())
+	require.Len(t, res.Tags, 0)
+	altEvidenceMsg := types.CreateTestEthMsg(t, accAddressVal2Pow6)
+	altEvidenceMsg.EthereumLogIndex = types.TestEthereumLogIndex + 1
+	res = handler(ctx, altEvidenceMsg)
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.FailedStatus)
+
+	//The failed item is queued for a refund to its ethereum sender and signaled in the tags
+	refund, found := keeper.GetRefund(ctx, types.TestItemID)
+	require.True(t, found)
+	require.Equal(t, types.TestEthereumAddress, refund.EthereumSender)
+	require.Equal(t, types.TestCoins, refund.Amount.String())
+	require.Equal(t, sdk.NewTags(TagRefundItemID, types.TestItemID, TagRefundSender, types.TestEthereumAddress), res.Tags)
+	require.Len(t, keeper.GetRefunds(ctx), 1)
+
+	//The refund leaves the queue once validators attest that peggy unlocked the item
+	releaseClaim := NewEthBridgeReleaseClaim(types.TestItemID, types.TestEthereumTxHash, 0, ReleaseKindUnlock,
+		types.TestEthereumAddress, accAddressVal1Pow4, refund.Amount)
+	res = handler(ctx, NewMsgMakeEthBridgeReleaseClaim(releaseClaim, nil))
+	require.True(t, res.IsOK())
+	releaseClaim.Validator = accAddressVal2Pow6
+	res = handler(ctx, NewMsgMakeEthBridgeReleaseClaim(releaseClaim, nil))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+	require.Len(t, keeper.GetRefunds(ctx), 0)
+}
+
+func TestSetTokenScalesValidatesParams(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, _, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	admin := sdk.AccAddress(validatorAddresses[0])
+	params := keeper.GetParams(ctx)
+	params.Admin = admin
+	params.MintLimits = MintLimits{NewMintLimit("ethereum", sdk.NewInt(100), sdk.ZeroInt())}
+	keeper.SetParams(ctx, params)
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	//A scale would leave the mint limit on a denomination that is no longer minted
+	scales := types.TokenScales{types.NewTokenScale("ethereum", "ueth", 18, 6, types.DustRuleReject)}
+	res := handler(ctx, types.NewMsgSetTokenScales(admin, scales))
+	require.Equal(t, types.CodeInvalidParams, res.Code)
+	require.Empty(t, keeper.GetParams(ctx).TokenScales)
+
+	//Once the limit names the minted denomination the scale is accepted
+	res = handler(ctx, NewMsgSetMintLimits(admin, 50, MintLimits{NewMintLimit("ueth", sdk.NewInt(100), sdk.ZeroInt())}))
+	require.True(t, res.IsOK())
+	res = handler(ctx, types.NewMsgSetTokenScales(admin, scales))
+	require.True(t, res.IsOK())
+	require.Equal(t, scales, keeper.GetParams(ctx).TokenScales)
+}
+
+func TestClaimsScaledOnSuccess(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{5, 5})
+	accAddressVal1Pow5 := sdk.AccAddress(validatorAddresses[0])
+	accAddressVal2Pow5 := sdk.AccAddress(validatorAddresses[1])
+	params := keeper.GetParams(ctx)
+	params.TokenScales = types.TokenScales{types.NewTokenScale("ethereum", "ueth", 18, 6, types.DustRuleRoundDown)}
+	keeper.SetParams(ctx, params)
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+
+	//The first claim is made under the old scales
+	ethClaim := types.CreateTestEthClaim(t, accAddressVal1Pow5, types.TestEthereumAddress, "3000000000123ethereum")
+	res := handler(ctx, types.NewMsgMakeEthBridgeClaim(ethClaim))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.PendingStatus)
+
+	//Claims still agree once the scales change, and the mint uses the scales in force when consensus is reached
+	params = keeper.GetParams(ctx)
+	params.TokenScales = types.TokenScales{types.NewTokenScale("ethereum", "ueth", 18, 9, types.DustRuleRoundDown)}
+	keeper.SetParams(ctx, params)
+	ethClaim = types.CreateTestEthClaim(t, accAddressVal2Pow5, types.TestEthereumAddress, "3000000000123ethereum")
+	res = handler(ctx, types.NewMsgMakeEthBridgeClaim(ethClaim))
+	require.True(t, res.IsOK())
+	require.Equal(t, res.Log, oracle.SuccessStatus)
+
+	receiverAddress, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiverAddress).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ueth", 3000))))
+	dusts := keeper.GetDusts(ctx)
+	require.Len(t, dusts, 1)
+	require.True(t, dusts[0].Amount.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 123))))
+}
+
+func TestSetTokenDenoms(t *testing.T) {
+	//Setup
+	cdc := codec.New()
+	ctx, _, keeper, _, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	admin := sdk.AccAddress(validatorAddresses[0])
+	params := keeper.GetParams(ctx)
+	params.Admin = admin
+	keeper.SetParams(ctx, params)
+
+	handler := NewHandler(keeper, cdc, types.DefaultCodespace)
+	token := "0x6b175474e89094c44da98b954eedeac495271d0f"
+	tokenDenoms := types.TokenDenoms{types.NewTokenDenom(token, "dai")}
+
+	//Only the admin can change the bridged tokens
+	res := handler(ctx, types.NewMsgSetTokenDenoms(sdk.AccAddress(validatorAddresses[1]), tokenDenoms))
+	require.Equal(t, types.CodeUnauthorized, res.Code)
+
+	//Tokens must be non-zero addresses mapped once to a valid denomination other than ether's
+	invalid := []types.TokenDenoms{
+		{types.NewTokenDenom("0x6b17", "dai")},
+		{types.NewTokenDenom("0x0000000000000000000000000000000000000000", "dai")},
+		{types.NewTokenDenom(token, "Dai")},
+		{types.NewTokenDenom(token, types.EtherDenom)},
+		{types.NewTokenDenom(token, "dai"), types.NewTokenDenom(strings.ToUpper(token[2:]), "mkr")},
+		{types.NewTokenDenom(token, "dai"), types.NewTokenDenom("0x9f8f72aa9304c8b593d555f12ef6589cc3a579a2", "dai")},
+	}
+	for _, tokenDenoms := range invalid {
+		require.Equal(t, types.CodeInvalidTokenDenom, tokenDenoms.ValidateBasic().Code())
+		res = handler(ctx, types.MsgSetTokenDenoms{Admin: admin, TokenDenoms: tokenDenoms})
+		require.Equal(t, types.CodeInvalidParams, res.Code)
+	}
+	require.Empty(t, keeper.GetParams(ctx).TokenDenoms)
+
+	res = handler(ctx, types.NewMsgSetTokenDenoms(admin, tokenDenoms))
+	require.True(t, res.IsOK())
+	require.Equal(t, tokenDenoms, keeper.GetParams(ctx).TokenDenoms)
+}
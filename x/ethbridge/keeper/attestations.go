@@ -0,0 +1,241 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// AddOutgoingTransfers records a transfer to ethereum for each coin of a burn, converted back to ethereum units by
+// the token scales, and opens their attestations
+func (k Keeper) AddOutgoingTransfers(ctx sdk.Context, sender sdk.AccAddress, ethereumReceiver string, amount sdk.Coins) types.OutgoingTransfers {
+	tokenScales := k.GetParams(ctx).TokenScales
+	transfers := make(types.OutgoingTransfers, len(amount))
+	for i, coin := range amount {
+		nonce := k.GetLastNonce(ctx, types.AttestationKindTransfer) + 1
+		transfers[i] = types.NewOutgoingTransfer(nonce, sender, common.NormalizeEthAddress(ethereumReceiver), coin,
+			tokenScales.ToEthereum(coin), ctx.BlockHeight())
+		k.SetOutgoingTransfer(ctx, transfers[i])
+		k.SetLastNonce(ctx, types.AttestationKindTransfer, nonce)
+		k.SetAttestation(ctx, types.NewAttestation(types.AttestationKindTransfer, nonce, transfers[i].Checkpoint(),
+			ctx.BlockHeight()))
+	}
+	return transfers
+}
+
+// GetOutgoingTransfer returns the outgoing transfer with a nonce
+func (k Keeper) GetOutgoingTransfer(ctx sdk.Context, nonce uint64) (types.OutgoingTransfer, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOutgoingTransferKey(nonce))
+	if bz == nil {
+		return types.OutgoingTransfer{}, false
+	}
+	var transfer types.OutgoingTransfer
+	k.cdc.MustUnmarshalBinaryBare(bz, &transfer)
+	return transfer, true
+}
+
+// SetOutgoingTransfer stores an outgoing transfer
+func (k Keeper) SetOutgoingTransfer(ctx sdk.Context, transfer types.OutgoingTransfer) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOutgoingTransferKey(transfer.Nonce), k.cdc.MustMarshalBinaryBare(transfer))
+}
+
+// GetOutgoingTransfers returns every outgoing transfer, by nonce
+func (k Keeper) GetOutgoingTransfers(ctx sdk.Context) types.OutgoingTransfers {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OutgoingTransferPrefix)
+	defer iterator.Close()
+
+	transfers := types.OutgoingTransfers{}
+	for ; iterator.Valid(); iterator.Next() {
+		var transfer types.OutgoingTransfer
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &transfer)
+		transfers = append(transfers, transfer)
+	}
+	return transfers
+}
+
+// UpdateValset checkpoints the bonded validators with a registered ethereum key whenever they or their powers change,
+// and opens the attestation of the new valset
+func (k Keeper) UpdateValset(ctx sdk.Context) {
+	var members []types.ValsetMember
+	for _, validator := range k.stakingKeeper.GetBondedValidatorsByPower(ctx) {
+		key, found := k.GetEthereumKey(ctx, validator.GetOperator())
+		if !found {
+			continue
+		}
+		members = append(members, types.NewValsetMember(validator.GetOperator(), key.EthereumAddress,
+			validator.GetTendermintPower()))
+	}
+	if len(members) == 0 {
+		return
+	}
+
+	nonce := k.GetLastNonce(ctx, types.AttestationKindValset)
+	valset := types.NewValset(nonce+1, members, ctx.BlockHeight())
+	if last, found := k.GetValset(ctx, nonce); found && last.SameMembers(valset) {
+		return
+	}
+	k.SetValset(ctx, valset)
+	k.SetLastNonce(ctx, types.AttestationKindValset, valset.Nonce)
+	k.SetAttestation(ctx, types.NewAttestation(types.AttestationKindValset, valset.Nonce, valset.Checkpoint(),
+		ctx.BlockHeight()))
+}
+
+// GetValset returns the valset with a nonce
+func (k Keeper) GetValset(ctx sdk.Context, nonce uint64) (types.Valset, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetValsetKey(nonce))
+	if bz == nil {
+		return types.Valset{}, false
+	}
+	var valset types.Valset
+	k.cdc.MustUnmarshalBinaryBare(bz, &valset)
+	return valset, true
+}
+
+// SetValset stores a valset
+func (k Keeper) SetValset(ctx sdk.Context, valset types.Valset) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetValsetKey(valset.Nonce), k.cdc.MustMarshalBinaryBare(valset))
+}
+
+// GetValsets returns every valset, by nonce
+func (k Keeper) GetValsets(ctx sdk.Context) types.Valsets {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.ValsetPrefix)
+	defer iterator.Close()
+
+	valsets := types.Valsets{}
+	for ; iterator.Valid(); iterator.Next() {
+		var valset types.Valset
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &valset)
+		valsets = append(valsets, valset)
+	}
+	return valsets
+}
+
+// GetLastNonce returns the nonce of the last transfer or valset
+func (k Keeper) GetLastNonce(ctx sdk.Context, kind string) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLastNonceKey(kind))
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetLastNonce sets the nonce of the last transfer or valset
+func (k Keeper) SetLastNonce(ctx sdk.Context, kind string, nonce uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLastNonceKey(kind), sdk.Uint64ToBigEndian(nonce))
+}
+
+// GetSigningValset returns the valset an ethereum contract verifies the signatures of an attestation against: the
+// last valset with a complete attestation, older than the attested valset itself for valset attestations, or the
+// first valset, which the contract is deployed with, until one is attested
+func (k Keeper) GetSigningValset(ctx sdk.Context, attestation types.Attestation) (types.Valset, bool) {
+	nonce := k.GetLastNonce(ctx, types.AttestationKindValset)
+	if attestation.Kind == types.AttestationKindValset && attestation.Nonce <= nonce {
+		nonce = attestation.Nonce - 1
+	}
+	for ; nonce > 1; nonce-- {
+		if valsetAttestation, found := k.GetAttestation(ctx, types.AttestationKindValset, nonce); found && valsetAttestation.Complete {
+			break
+		}
+	}
+	if nonce == 0 {
+		nonce = 1
+	}
+	return k.GetValset(ctx, nonce)
+}
+
+// SignAttestation adds a validator's signature over the checkpoint of a transfer or valset. The validator must be a
+// member of the signing valset and sign with the ethereum key recorded for it there. Every signature is weighed by
+// its signer's power in that valset, and the attestation is complete once the signers hold the signature threshold
+// of the valset's total power.
+func (k Keeper) SignAttestation(ctx sdk.Context, kind string, nonce uint64, validator sdk.ValAddress, signature []byte) (types.Attestation, sdk.Error) {
+	attestation, found := k.GetAttestation(ctx, kind, nonce)
+	if !found {
+		return types.Attestation{}, types.ErrAttestationNotFound(k.Codespace())
+	}
+	if attestation.HasSigned(validator) {
+		return types.Attestation{}, types.ErrDuplicateAttestationSignature(k.Codespace())
+	}
+	valset, found := k.GetSigningValset(ctx, attestation)
+	if !found {
+		return types.Attestation{}, types.ErrNotValsetMember(k.Codespace())
+	}
+	member, found := valset.Member(validator)
+	if !found {
+		return types.Attestation{}, types.ErrNotValsetMember(k.Codespace())
+	}
+	signer, err := common.RecoverEthSigner(attestation.CheckpointBytes(), signature)
+	if err != nil {
+		return types.Attestation{}, types.ErrInvalidEthereumKeySignature(k.Codespace(), err.Error())
+	}
+	if signer.Hex() != common.NormalizeEthAddress(member.EthereumAddress) {
+		return types.Attestation{}, types.ErrInvalidEthereumKeySignature(k.Codespace(), "not signed by the key of the valset member")
+	}
+
+	//Signatures made against an older valset are weighed again by the current one, and only count if their signer
+	//and key are still members of it
+	attestation.Signatures = append(attestation.Signatures,
+		types.NewAttestationSignature(validator, member.EthereumAddress, member.Power, signature))
+	attestation.ValsetNonce = valset.Nonce
+	attestation.SignedPower = 0
+	for i, signed := range attestation.Signatures {
+		signed.Power = 0
+		if signedMember, found := valset.Member(signed.Validator); found && signedMember.EthereumAddress == signed.EthereumAddress {
+			signed.Power = signedMember.Power
+		}
+		attestation.Signatures[i] = signed
+		attestation.SignedPower += signed.Power
+	}
+	if totalPower := valset.TotalPower(); totalPower > 0 {
+		signedShare := sdk.NewDec(attestation.SignedPower).QuoInt64(totalPower)
+		attestation.Complete = signedShare.GTE(k.GetParams(ctx).SignatureThreshold)
+	}
+	k.SetAttestation(ctx, attestation)
+	return attestation, nil
+}
+
+// GetAttestation returns the attestation of a transfer or valset
+func (k Keeper) GetAttestation(ctx sdk.Context, kind string, nonce uint64) (types.Attestation, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetAttestationKey(kind, nonce))
+	if bz == nil {
+		return types.Attestation{}, false
+	}
+	var attestation types.Attestation
+	k.cdc.MustUnmarshalBinaryBare(bz, &attestation)
+	return attestation, true
+}
+
+// SetAttestation stores an attestation
+func (k Keeper) SetAttestation(ctx sdk.Context, attestation types.Attestation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetAttestationKey(attestation.Kind, attestation.Nonce), k.cdc.MustMarshalBinaryBare(attestation))
+}
+
+// GetAttestations returns every attestation of a kind, by nonce, or of every kind when kind is empty
+func (k Keeper) GetAttestations(ctx sdk.Context, kind string) types.Attestations {
+	prefix := types.AttestationPrefix
+	if kind != "" {
+		prefix = types.GetAttestationPrefixKey(kind)
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	attestations := types.Attestations{}
+	for ; iterator.Valid(); iterator.Next() {
+		var attestation types.Attestation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &attestation)
+		attestations = append(attestations, attestation)
+	}
+	return attestations
+}
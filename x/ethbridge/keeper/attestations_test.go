@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingKeeperLib "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestAttestations(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	ethKeys := make([]*ecdsa.PrivateKey, len(validatorAddresses))
+	for i, validator := range validatorAddresses {
+		var err error
+		ethKeys[i], err = crypto.GenerateKey()
+		require.NoError(t, err)
+		require.NoError(t, keeper.RegisterEthereumKey(ctx, validator, crypto.PubkeyToAddress(ethKeys[i].PublicKey).Hex()))
+	}
+	sign := func(checkpoint []byte, key *ecdsa.PrivateKey) []byte {
+		signature, err := crypto.Sign(common.EthSignedMessageHash(checkpoint), key)
+		require.NoError(t, err)
+		return signature
+	}
+
+	//The validator set is checkpointed once, until it changes
+	keeper.UpdateValset(ctx)
+	keeper.UpdateValset(ctx)
+	valsets := keeper.GetValsets(ctx)
+	require.Len(t, valsets, 1)
+	require.Equal(t, validatorAddresses[1], valsets[0].Members[0].Validator)
+	require.Equal(t, int64(7), valsets[0].Members[0].Power)
+
+	//Each coin of a burn is an outgoing transfer with its own attestation
+	amount := sdk.NewCoins(sdk.NewInt64Coin("eth", 5), sdk.NewInt64Coin("ethereum", 2))
+	transfers := keeper.AddOutgoingTransfers(ctx, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, amount)
+	require.Len(t, transfers, 2)
+	require.Equal(t, uint64(2), transfers[1].Nonce)
+	attestation, found := keeper.GetAttestation(ctx, types.AttestationKindTransfer, 1)
+	require.True(t, found)
+	checkpoint := transfers[0].Checkpoint()
+	require.Equal(t, checkpoint, attestation.CheckpointBytes())
+
+	//Only signatures made with the registered key count
+	_, err := keeper.SignAttestation(ctx, types.AttestationKindTransfer, 1, validatorAddresses[0], sign(checkpoint, ethKeys[1]))
+	require.Equal(t, types.CodeInvalidEthereumKeySignature, err.Code())
+	_, err = keeper.SignAttestation(ctx, types.AttestationKindTransfer, 3, validatorAddresses[0], sign(checkpoint, ethKeys[0]))
+	require.Equal(t, types.CodeAttestationNotFound, err.Code())
+
+	attestation, err = keeper.SignAttestation(ctx, types.AttestationKindTransfer, 1, validatorAddresses[0], sign(checkpoint, ethKeys[0]))
+	require.Nil(t, err)
+	require.False(t, attestation.Complete)
+	_, err = keeper.SignAttestation(ctx, types.AttestationKindTransfer, 1, validatorAddresses[0], sign(checkpoint, ethKeys[0]))
+	require.Equal(t, types.CodeDuplicateAttestationSignature, err.Code())
+
+	//The attestation is complete once the signers hold the signature threshold of the power
+	attestation, err = keeper.SignAttestation(ctx, types.AttestationKindTransfer, 1, validatorAddresses[1], sign(checkpoint, ethKeys[1]))
+	require.Nil(t, err)
+	require.True(t, attestation.Complete)
+	require.Equal(t, int64(10), attestation.SignedPower)
+	require.Len(t, attestation.Signatures, 2)
+	require.Len(t, keeper.GetAttestations(ctx, types.AttestationKindTransfer), 2)
+	require.Len(t, keeper.GetAttestations(ctx, ""), 3)
+}
+
+func TestAttestationPowerChange(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	ethKeys := make([]*ecdsa.PrivateKey, len(validatorAddresses))
+	for i, validator := range validatorAddresses {
+		var err error
+		ethKeys[i], err = crypto.GenerateKey()
+		require.NoError(t, err)
+		require.NoError(t, keeper.RegisterEthereumKey(ctx, validator, crypto.PubkeyToAddress(ethKeys[i].PublicKey).Hex()))
+	}
+	sign := func(kind string, nonce uint64, validator sdk.ValAddress, key *ecdsa.PrivateKey) (types.Attestation, sdk.Error) {
+		attestation, found := keeper.GetAttestation(ctx, kind, nonce)
+		require.True(t, found)
+		signature, err := crypto.Sign(common.EthSignedMessageHash(attestation.CheckpointBytes()), key)
+		require.NoError(t, err)
+		return keeper.SignAttestation(ctx, kind, nonce, validator, signature)
+	}
+	receiver := sdk.AccAddress(validatorAddresses[0])
+
+	//Nothing can be signed before a valset is checkpointed
+	keeper.AddOutgoingTransfers(ctx, receiver, types.TestEthereumAddress, sdk.NewCoins(sdk.NewInt64Coin("eth", 1)))
+	_, err := sign(types.AttestationKindTransfer, 1, validatorAddresses[0], ethKeys[0])
+	require.Equal(t, types.CodeNotValsetMember, err.Code())
+
+	//The first valset is checkpointed with powers 3 and 7, then the first validator's power rises to 30
+	keeper.UpdateValset(ctx)
+	validator, found := keeper.stakingKeeper.GetValidator(ctx, validatorAddresses[0])
+	require.True(t, found)
+	pool := keeper.stakingKeeper.GetPool(ctx)
+	pool.NotBondedTokens = pool.NotBondedTokens.Add(sdk.TokensFromTendermintPower(27))
+	validator, pool, _ = validator.AddTokensFromDel(pool, sdk.TokensFromTendermintPower(27))
+	keeper.stakingKeeper.SetPool(ctx, pool)
+	stakingKeeperLib.TestingUpdateValidator(keeper.stakingKeeper, ctx, validator, true)
+	require.Equal(t, int64(37), keeper.stakingKeeper.GetLastTotalPower(ctx).Int64())
+
+	//Signatures are still weighed by the attested valset, not by the bonded power
+	attestation, err := sign(types.AttestationKindTransfer, 1, validatorAddresses[0], ethKeys[0])
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), attestation.ValsetNonce)
+	require.Equal(t, int64(3), attestation.SignedPower)
+	require.False(t, attestation.Complete)
+	attestation, err = sign(types.AttestationKindTransfer, 1, validatorAddresses[1], ethKeys[1])
+	require.Nil(t, err)
+	require.Equal(t, int64(10), attestation.SignedPower)
+	require.True(t, attestation.Complete)
+
+	//The second valset is attested by the members of the first one
+	keeper.UpdateValset(ctx)
+	valset, found := keeper.GetValset(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, int64(30), valset.Members[0].Power)
+	attestation, err = sign(types.AttestationKindValset, 2, validatorAddresses[0], ethKeys[0])
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), attestation.ValsetNonce)
+	require.False(t, attestation.Complete)
+
+	//Until it is, transfers are still weighed by the first valset
+	keeper.AddOutgoingTransfers(ctx, receiver, types.TestEthereumAddress, sdk.NewCoins(sdk.NewInt64Coin("eth", 2)))
+	attestation, err = sign(types.AttestationKindTransfer, 2, validatorAddresses[1], ethKeys[1])
+	require.Nil(t, err)
+	require.Equal(t, uint64(1), attestation.ValsetNonce)
+	require.True(t, attestation.Complete)
+
+	attestation, err = sign(types.AttestationKindValset, 2, validatorAddresses[1], ethKeys[1])
+	require.Nil(t, err)
+	require.True(t, attestation.Complete)
+
+	//Once the second valset is attested, its powers and keys are the ones that count
+	newKey, keyErr := crypto.GenerateKey()
+	require.NoError(t, keyErr)
+	require.NoError(t, keeper.RegisterEthereumKey(ctx, validatorAddresses[1], crypto.PubkeyToAddress(newKey.PublicKey).Hex()))
+	keeper.AddOutgoingTransfers(ctx, receiver, types.TestEthereumAddress, sdk.NewCoins(sdk.NewInt64Coin("eth", 3)))
+	_, err = sign(types.AttestationKindTransfer, 3, validatorAddresses[1], newKey)
+	require.Equal(t, types.CodeInvalidEthereumKeySignature, err.Code())
+	attestation, err = sign(types.AttestationKindTransfer, 3, validatorAddresses[1], ethKeys[1])
+	require.Nil(t, err)
+	require.Equal(t, uint64(2), attestation.ValsetNonce)
+	require.Equal(t, int64(7), attestation.SignedPower)
+	require.False(t, attestation.Complete)
+	attestation, err = sign(types.AttestationKindTransfer, 3, validatorAddresses[0], ethKeys[0])
+	require.Nil(t, err)
+	require.Equal(t, int64(37), attestation.SignedPower)
+	require.True(t, attestation.Complete)
+}
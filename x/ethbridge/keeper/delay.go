@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// GetPendingRelease returns the pending release of a peggy item
+func (k Keeper) GetPendingRelease(ctx sdk.Context, itemID string) (types.PendingRelease, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPendingReleaseKey(itemID))
+	if bz == nil {
+		return types.PendingRelease{}, false
+	}
+	var release types.PendingRelease
+	k.cdc.MustUnmarshalBinaryBare(bz, &release)
+	return release, true
+}
+
+// SetPendingRelease places a large transfer in the pending-release queue until its release height
+func (k Keeper) SetPendingRelease(ctx sdk.Context, release types.PendingRelease) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPendingReleaseKey(release.ItemID), k.cdc.MustMarshalBinaryBare(release))
+	store.Set(types.GetPendingReleaseHeightKey(release.ReleaseHeight, release.ItemID), []byte(release.ItemID))
+}
+
+// GetPendingReleases returns every pending release
+func (k Keeper) GetPendingReleases(ctx sdk.Context) types.PendingReleases {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PendingReleasePrefix)
+	defer iterator.Close()
+
+	releases := types.PendingReleases{}
+	for ; iterator.Valid(); iterator.Next() {
+		var release types.PendingRelease
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &release)
+		releases = append(releases, release)
+	}
+	return releases
+}
+
+// CancelRelease removes a transfer from the pending-release queue, along with its post-mint actions, so its coins
+// are never minted
+func (k Keeper) CancelRelease(ctx sdk.Context, itemID string) (types.PendingRelease, sdk.Error) {
+	release, found := k.GetPendingRelease(ctx, itemID)
+	if !found {
+		return types.PendingRelease{}, types.ErrPendingReleaseNotFound(k.Codespace())
+	}
+	k.deletePendingRelease(ctx, release)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPostMintKey(itemID))
+	return release, nil
+}
+
+// IsGuardian returns whether an address is the ethbridge guardian
+func (k Keeper) IsGuardian(ctx sdk.Context, address sdk.AccAddress) bool {
+	guardian := k.GetParams(ctx).Guardian
+	return !guardian.Empty() && guardian.Equals(address)
+}
+
+// ReleaseDuePendingReleases mints the pending releases whose release height has been reached. They still pass
+// through the circuit breaker and the mint limits. Each release runs in its own cache context, so a release that
+// fails leaves no partial state behind; it is moved to the queued mints instead, where the admin can inspect and
+// release it.
+func (k Keeper) ReleaseDuePendingReleases(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.PendingReleaseHeightPrefix, types.GetPendingReleaseHeightPrefixKey(ctx.BlockHeight()+1))
+	var itemIDs []string
+	for ; iterator.Valid(); iterator.Next() {
+		itemIDs = append(itemIDs, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	for _, itemID := range itemIDs {
+		release, found := k.GetPendingRelease(ctx, itemID)
+		if !found {
+			continue
+		}
+		releaseCtx, write := ctx.CacheContext()
+		k.deletePendingRelease(releaseCtx, release)
+		if err := k.mintOrQueue(releaseCtx, release.ItemID, release.Receiver, release.Amount); err != nil {
+			ctx.Logger().Error(fmt.Sprintf("failed to release pending mint for item %s: %s", release.ItemID, err))
+			k.deletePendingRelease(ctx, release)
+			k.SetQueuedMint(ctx, types.NewQueuedMint(release.ItemID, release.Receiver, release.Amount,
+				types.QueueReasonReleaseFailed, ctx.BlockHeight()))
+			continue
+		}
+		write()
+	}
+}
+
+// delayOrMint places a successful claim above the delay threshold in the pending-release queue and mints any other
+func (k Keeper) delayOrMint(ctx sdk.Context, itemID string, receiver sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	params := k.GetParams(ctx)
+	if params.ReleaseDelay == 0 || !params.ExceedsDelayThreshold(amount) {
+		return k.mintOrQueue(ctx, itemID, receiver, amount)
+	}
+	if _, found := k.GetMintedClaim(ctx, itemID); found {
+		return types.ErrAlreadyMinted(k.Codespace())
+	}
+	k.SetPendingRelease(ctx, types.NewPendingRelease(itemID, receiver, amount, ctx.BlockHeight()+params.ReleaseDelay))
+	return nil
+}
+
+func (k Keeper) deletePendingRelease(ctx sdk.Context, release types.PendingRelease) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetPendingReleaseKey(release.ItemID))
+	store.Delete(types.GetPendingReleaseHeightKey(release.ReleaseHeight, release.ItemID))
+}
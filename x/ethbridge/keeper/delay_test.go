@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestLargeTransfersAreDelayed(t *testing.T) {
+	ctx, _, keeper, bankKeeper, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	amount, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.ReleaseDelay = 5
+	params.DelayThresholds = sdk.NewCoins(sdk.NewInt64Coin("ethereum", 9))
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(1)
+
+	//Transfers at or below the threshold are minted immediately
+	err = keeper.delayOrMint(ctx, types.AltTestItemID, receiver, sdk.NewCoins(sdk.NewInt64Coin("ethereum", 9)))
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 9))))
+
+	//Transfers above the threshold wait out the release delay
+	err = keeper.delayOrMint(ctx, types.TestItemID, receiver, amount)
+	require.NoError(t, err)
+	release, found := keeper.GetPendingRelease(ctx, types.TestItemID)
+	require.True(t, found)
+	require.Equal(t, int64(6), release.ReleaseHeight)
+
+	keeper.ReleaseDuePendingReleases(ctx.WithBlockHeight(5))
+	require.Len(t, keeper.GetPendingReleases(ctx), 1)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 9))))
+
+	keeper.ReleaseDuePendingReleases(ctx.WithBlockHeight(6))
+	require.Len(t, keeper.GetPendingReleases(ctx), 0)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 19))))
+	require.NoError(t, SupplyInvariant(keeper)(ctx))
+}
+
+func TestCancelRelease(t *testing.T) {
+	ctx, _, keeper, bankKeeper, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	amount, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.ReleaseDelay = 5
+	params.DelayThresholds = sdk.NewCoins(sdk.NewInt64Coin("ethereum", 1))
+	keeper.SetParams(ctx, params)
+
+	err = keeper.delayOrMint(ctx, types.TestItemID, receiver, amount)
+	require.NoError(t, err)
+
+	_, err = keeper.CancelRelease(ctx, types.TestItemID)
+	require.NoError(t, err)
+	_, err = keeper.CancelRelease(ctx, types.TestItemID)
+	require.Error(t, err)
+
+	//A cancelled release is never minted
+	keeper.ReleaseDuePendingReleases(ctx.WithBlockHeight(10))
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsZero())
+	require.True(t, keeper.GetSupply(ctx, "ethereum").Minted.IsZero())
+}
+
+func TestFailedReleaseIsQueued(t *testing.T) {
+	ctx, _, keeper, bankKeeper, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	amount, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.ReleaseDelay = 5
+	params.DelayThresholds = sdk.NewCoins(sdk.NewInt64Coin("ethereum", 1))
+	keeper.SetParams(ctx, params)
+
+	err = keeper.delayOrMint(ctx, types.TestItemID, receiver, amount)
+	require.NoError(t, err)
+
+	//The item is minted by other means while its release is pending, so the release fails
+	err = keeper.MintCoins(ctx, types.TestItemID, receiver, amount)
+	require.NoError(t, err)
+	keeper.ReleaseDuePendingReleases(ctx.WithBlockHeight(10))
+
+	//Nothing is minted twice, and the release is kept as a queued mint instead of being lost
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(amount))
+	require.True(t, keeper.GetSupply(ctx, "ethereum").Minted.Equal(amount.AmountOf("ethereum")))
+	require.Len(t, keeper.GetPendingReleases(ctx), 0)
+	mint, found := keeper.GetQueuedMint(ctx, types.TestItemID)
+	require.True(t, found)
+	require.Equal(t, types.QueueReasonReleaseFailed, mint.Reason)
+	require.True(t, mint.Amount.IsEqual(amount))
+	require.NoError(t, SupplyInvariant(keeper)(ctx))
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// GetEthereumKey returns the ethereum key a validator registered
+func (k Keeper) GetEthereumKey(ctx sdk.Context, validator sdk.ValAddress) (types.EthereumKey, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEthereumKeyKey(validator))
+	if bz == nil {
+		return types.EthereumKey{}, false
+	}
+	var key types.EthereumKey
+	k.cdc.MustUnmarshalBinaryBare(bz, &key)
+	return key, true
+}
+
+// GetValidatorByEthereumAddress returns the validator that registered an ethereum address
+func (k Keeper) GetValidatorByEthereumAddress(ctx sdk.Context, ethereumAddress string) (sdk.ValAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetEthereumAddressKey(ethereumAddress))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.ValAddress(bz), true
+}
+
+// SetEthereumKey stores the ethereum key of a validator, replacing the one it registered before
+func (k Keeper) SetEthereumKey(ctx sdk.Context, key types.EthereumKey) {
+	store := ctx.KVStore(k.storeKey)
+	if previous, found := k.GetEthereumKey(ctx, key.Validator); found {
+		store.Delete(types.GetEthereumAddressKey(previous.EthereumAddress))
+	}
+	store.Set(types.GetEthereumKeyKey(key.Validator), k.cdc.MustMarshalBinaryBare(key))
+	store.Set(types.GetEthereumAddressKey(key.EthereumAddress), key.Validator.Bytes())
+}
+
+// RegisterEthereumKey sets or rotates the ethereum key of an existing validator. An ethereum address can only
+// identify one validator.
+func (k Keeper) RegisterEthereumKey(ctx sdk.Context, validator sdk.ValAddress, ethereumAddress string) sdk.Error {
+	if _, found := k.stakingKeeper.GetValidator(ctx, validator); !found {
+		return staking.ErrNoValidatorFound(staking.DefaultCodespace)
+	}
+	if owner, found := k.GetValidatorByEthereumAddress(ctx, ethereumAddress); found && !owner.Equals(validator) {
+		return types.ErrEthereumKeyInUse(k.Codespace())
+	}
+	k.SetEthereumKey(ctx, types.NewEthereumKey(validator, common.NormalizeEthAddress(ethereumAddress), ctx.BlockHeight()))
+	return nil
+}
+
+// GetEthereumKeys returns the ethereum key of every validator that registered one
+func (k Keeper) GetEthereumKeys(ctx sdk.Context) types.EthereumKeys {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EthereumKeyPrefix)
+	defer iterator.Close()
+
+	keys := types.EthereumKeys{}
+	for ; iterator.Valid(); iterator.Next() {
+		var key types.EthereumKey
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &key)
+		keys = append(keys, key)
+	}
+	return keys
+}
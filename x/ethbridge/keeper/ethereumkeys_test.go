@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestRegisterEthereumKey(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	validator := validatorAddresses[0]
+
+	//The registration must be signed by the ethereum key of the registered address
+	ethKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	ethAddress := crypto.PubkeyToAddress(ethKey.PublicKey).Hex()
+	signature, err := crypto.Sign(common.EthSignedMessageHash(types.EthereumKeySignBytes(ctx.ChainID(), validator)), ethKey)
+	require.NoError(t, err)
+	msg := types.NewMsgRegisterEthereumKey(validator, ethAddress, signature)
+	require.NoError(t, msg.ValidateBasic())
+	require.NoError(t, msg.VerifySignature(ctx.ChainID()))
+	require.Error(t, types.NewMsgRegisterEthereumKey(validatorAddresses[1], ethAddress, signature).VerifySignature(ctx.ChainID()))
+	require.Error(t, types.NewMsgRegisterEthereumKey(validator, types.TestEthereumAddress, signature).VerifySignature(ctx.ChainID()))
+
+	//The signature can't be replayed on another chain, nor taken from a signature over the bare operator address
+	require.Error(t, msg.VerifySignature("otherchainid"))
+	bareSignature, err := crypto.Sign(common.EthSignedMessageHash(validator.Bytes()), ethKey)
+	require.NoError(t, err)
+	require.Error(t, types.NewMsgRegisterEthereumKey(validator, ethAddress, bareSignature).VerifySignature(ctx.ChainID()))
+
+	require.NoError(t, keeper.RegisterEthereumKey(ctx, validator, ethAddress))
+	key, found := keeper.GetEthereumKey(ctx, validator)
+	require.True(t, found)
+	require.Equal(t, ethAddress, key.EthereumAddress)
+
+	//An ethereum address identifies a single validator
+	inUseErr := keeper.RegisterEthereumKey(ctx, validatorAddresses[1], ethAddress)
+	require.Equal(t, types.CodeEthereumKeyInUse, inUseErr.Code())
+
+	//Rotating the key frees the previous address
+	require.NoError(t, keeper.RegisterEthereumKey(ctx, validator, types.TestEthereumAddress))
+	_, found = keeper.GetValidatorByEthereumAddress(ctx, ethAddress)
+	require.False(t, found)
+	owner, found := keeper.GetValidatorByEthereumAddress(ctx, types.TestEthereumAddress)
+	require.True(t, found)
+	require.Equal(t, validator, owner)
+	require.NoError(t, keeper.RegisterEthereumKey(ctx, validatorAddresses[1], ethAddress))
+	require.Len(t, keeper.GetEthereumKeys(ctx), 2)
+}
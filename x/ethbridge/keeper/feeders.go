@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// GetFeeder returns the account a validator registered to sign claims on its behalf
+func (k Keeper) GetFeeder(ctx sdk.Context, validator sdk.ValAddress) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeederKey(validator))
+	if bz == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(bz), true
+}
+
+// SetFeeder registers the account that signs claims on behalf of a validator. An empty feeder removes it.
+func (k Keeper) SetFeeder(ctx sdk.Context, validator sdk.ValAddress, feeder sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	if feeder.Empty() {
+		store.Delete(types.GetFeederKey(validator))
+		return
+	}
+	store.Set(types.GetFeederKey(validator), feeder.Bytes())
+}
+
+// RegisterFeeder sets the feeder of an existing validator
+func (k Keeper) RegisterFeeder(ctx sdk.Context, validator sdk.ValAddress, feeder sdk.AccAddress) sdk.Error {
+	if _, found := k.stakingKeeper.GetValidator(ctx, validator); !found {
+		return staking.ErrNoValidatorFound(staking.DefaultCodespace)
+	}
+	k.SetFeeder(ctx, validator, feeder)
+	return nil
+}
+
+// GetFeeders returns every registered feeder
+func (k Keeper) GetFeeders(ctx sdk.Context) types.Feeders {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.FeederPrefix)
+	defer iterator.Close()
+
+	feeders := types.Feeders{}
+	for ; iterator.Valid(); iterator.Next() {
+		validator := sdk.ValAddress(iterator.Key()[len(types.FeederPrefix):])
+		feeders = append(feeders, types.NewFeeder(validator, sdk.AccAddress(iterator.Value())))
+	}
+	return feeders
+}
+
+// ValidateClaimSigner checks that a claim is made for a bonded validator and, when it is signed by a feeder,
+// that the feeder is the one the validator registered
+func (k Keeper) ValidateClaimSigner(ctx sdk.Context, validator sdk.AccAddress, feeder sdk.AccAddress) sdk.Error {
+	valAddress := sdk.ValAddress(validator)
+	stakingValidator, found := k.stakingKeeper.GetValidator(ctx, valAddress)
+	if !found || stakingValidator.GetStatus() != sdk.Bonded {
+		return types.ErrValidatorNotBonded(k.Codespace())
+	}
+	if feeder.Empty() {
+		return nil
+	}
+	registered, found := k.GetFeeder(ctx, valAddress)
+	if !found || !registered.Equals(feeder) {
+		return types.ErrInvalidFeeder(k.Codespace())
+	}
+	return nil
+}
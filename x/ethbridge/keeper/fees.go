@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// GetFeeRewards returns the bridge fee rewards paid to a validator
+func (k Keeper) GetFeeRewards(ctx sdk.Context, validator sdk.ValAddress) types.FeeRewards {
+	return k.getFeeRewards(ctx, types.GetValidatorFeeRewardsPrefixKey(validator))
+}
+
+// GetAllFeeRewards returns the bridge fee rewards paid to every validator
+func (k Keeper) GetAllFeeRewards(ctx sdk.Context) types.FeeRewards {
+	return k.getFeeRewards(ctx, types.FeeRewardPrefix)
+}
+
+func (k Keeper) getFeeRewards(ctx sdk.Context, prefix []byte) types.FeeRewards {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	rewards := types.FeeRewards{}
+	for ; iterator.Valid(); iterator.Next() {
+		var reward types.FeeReward
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &reward)
+		rewards = append(rewards, reward)
+	}
+	return rewards
+}
+
+// distributeBridgeFee pays the bridge fee of a claim from the fee pool to the bonded validators whose claims matched
+// the final claim, pro-rata to their power. Whatever cannot be split evenly stays in the fee pool.
+func (k Keeper) distributeBridgeFee(ctx sdk.Context, itemID string, fee sdk.Coins) sdk.Error {
+	prophecy, err := k.oracleKeeper.GetProphecy(ctx, itemID)
+	if err != nil {
+		return nil
+	}
+
+	var validators []sdk.ValAddress
+	var powers []int64
+	totalPower := int64(0)
+	for _, validatorAddress := range prophecy.ClaimValidators[prophecy.Status.FinalClaim] {
+		validator, found := k.stakingKeeper.GetValidator(ctx, validatorAddress)
+		if !found || validator.GetStatus() != sdk.Bonded {
+			continue
+		}
+		validators = append(validators, validatorAddress)
+		powers = append(powers, validator.GetTendermintPower())
+		totalPower += validator.GetTendermintPower()
+	}
+	if totalPower == 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	for i, validatorAddress := range validators {
+		share := sdk.Coins{}
+		for _, coin := range fee {
+			amount := coin.Amount.MulRaw(powers[i]).QuoRaw(totalPower)
+			if amount.IsPositive() {
+				share = share.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, amount)))
+			}
+		}
+		if share.IsZero() {
+			continue
+		}
+		_, err := k.bankKeeper.SendCoins(ctx, types.FeePoolAddress, sdk.AccAddress(validatorAddress), share)
+		if err != nil {
+			return err
+		}
+		reward := types.NewFeeReward(itemID, validatorAddress, share, ctx.BlockHeight())
+		store.Set(types.GetFeeRewardKey(validatorAddress, itemID), k.cdc.MustMarshalBinaryBare(reward))
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestComputeBridgeFees(t *testing.T) {
+	fees := types.BridgeFees{
+		types.NewBridgeFee("ethereum", sdk.NewDecWithPrec(1, 1), sdk.NewInt(2)),
+		types.NewBridgeFee("flat", sdk.ZeroDec(), sdk.NewInt(5)),
+	}
+	require.NoError(t, fees.ValidateBasic())
+
+	//Flat plus a truncated rate of the amount
+	require.True(t, fees.Compute(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 19))).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 3))))
+
+	//Never more than the amount, and nothing for denominations without a fee
+	amount := sdk.NewCoins(sdk.NewInt64Coin("flat", 3), sdk.NewInt64Coin("stake", 100))
+	require.True(t, fees.Compute(amount).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("flat", 3))))
+
+	//Rates above 1 and duplicate denominations are invalid
+	require.Error(t, types.BridgeFees{types.NewBridgeFee("ethereum", sdk.NewDec(2), sdk.ZeroInt())}.ValidateBasic())
+	require.Error(t, append(fees, fees[0]).ValidateBasic())
+}
+
+func TestMintWithoutAttestingValidatorsKeepsFee(t *testing.T) {
+	ctx, _, keeper, bankKeeper, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	amount, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.BridgeFees = types.BridgeFees{types.NewBridgeFee("ethereum", sdk.NewDecWithPrec(2, 1), sdk.ZeroInt())}
+	keeper.SetParams(ctx, params)
+
+	//Without a prophecy there is no one to pay, so the fee stays in the fee pool
+	err = keeper.MintCoins(ctx, types.TestItemID, receiver, amount)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 8))))
+	require.True(t, bankKeeper.GetCoins(ctx, types.FeePoolAddress).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 2))))
+	require.True(t, bankKeeper.GetCoins(ctx, types.ModuleAddress).IsZero())
+	require.Equal(t, sdk.NewInt(10), keeper.GetSupply(ctx, "ethereum").Minted)
+	require.Len(t, keeper.GetAllFeeRewards(ctx), 0)
+	require.NoError(t, SupplyInvariant(keeper)(ctx))
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// RegisterInvariants registers the ethbridge invariants
+func RegisterInvariants(c types.CrisisKeeper, k Keeper) {
+	c.RegisterRoute(types.ModuleName, "bridged-supply",
+		SupplyInvariant(k))
+}
+
+// SupplyInvariant checks that the outstanding supply of every bridged denomination equals the sum of the
+// coins minted for successful claims minus the coins burned
+func SupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) error {
+		claimed := sdk.NewCoins()
+		k.IterateMintedClaims(ctx, func(_ string, amount sdk.Coins) bool {
+			claimed = claimed.Add(amount)
+			return false
+		})
+
+		for _, supply := range k.GetSupplies(ctx) {
+			expected := claimed.AmountOf(supply.Denom).Sub(supply.Burned)
+			if !supply.Outstanding.Equal(expected) {
+				return fmt.Errorf("outstanding supply of %s is %s but successful claims minus burns is %s",
+					supply.Denom, supply.Outstanding, expected)
+			}
+			if !supply.Minted.Equal(claimed.AmountOf(supply.Denom)) {
+				return fmt.Errorf("minted supply of %s is %s but successful claims sum to %s",
+					supply.Denom, supply.Minted, claimed.AmountOf(supply.Denom))
+			}
+		}
+		for _, coin := range claimed {
+			if k.GetSupply(ctx, coin.Denom).Minted.IsZero() {
+				return fmt.Errorf("successful claims minted %s but no supply of %s is recorded", coin, coin.Denom)
+			}
+		}
+		return nil
+	}
+}
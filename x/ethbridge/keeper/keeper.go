@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+	ethheaders "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/keeper"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper maintains the link to data storage and exposes getter/setter methods for the ethbridge state
+type Keeper struct {
+	oracleKeeper  oracle.Keeper
+	bankKeeper    bank.Keeper
+	stakingKeeper staking.Keeper
+
+	ethHeadersKeeper ethheaders.Keeper
+
+	storeKey   sdk.StoreKey // Unexposed key to access store from sdk.Context
+	paramSpace params.Subspace
+
+	cdc *codec.Codec // The wire codec for binary encoding/decoding.
+
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates new instances of the ethbridge Keeper
+func NewKeeper(oracleKeeper oracle.Keeper, bankKeeper bank.Keeper, stakingKeeper staking.Keeper,
+	ethHeadersKeeper ethheaders.Keeper, storeKey sdk.StoreKey, paramSpace params.Subspace, cdc *codec.Codec,
+	codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		oracleKeeper:     oracleKeeper,
+		bankKeeper:       bankKeeper,
+		stakingKeeper:    stakingKeeper,
+		ethHeadersKeeper: ethHeadersKeeper,
+		storeKey:         storeKey,
+		paramSpace:       paramSpace.WithKeyTable(types.ParamKeyTable()),
+		cdc:              cdc,
+		codespace:        codespace,
+	}
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetProphecy gets the oracle prophecy for a given peggy item id
+func (k Keeper) GetProphecy(ctx sdk.Context, itemID string) (oracle.Prophecy, sdk.Error) {
+	return k.oracleKeeper.GetProphecy(ctx, common.NormalizeEthHash(itemID))
+}
+
+// ProcessClaim forwards an EthBridgeClaim to the oracle and indexes the prophecy by the claimed ethereum transaction.
+// Claims above a consensus tier request that tier's higher consensus from the oracle.
+func (k Keeper) ProcessClaim(ctx sdk.Context, claim types.EthBridgeClaim) (oracle.Status, sdk.Error) {
+	// Consensus tiers are set on the minted denominations, and claims that cannot be scaled are rejected early
+	scaled, _, err := k.ScaleAmount(ctx, claim.Amount)
+	if err != nil {
+		return oracle.Status{}, err
+	}
+	oracleId, validator, claimString := types.CreateOracleClaimFromEthClaim(k.cdc, claim)
+	consensusNeeded := k.GetParams(ctx).ConsensusTiers.ConsensusNeeded(scaled)
+	status, err := k.oracleKeeper.ProcessClaimWithConsensus(ctx, oracleId, validator, claimString, consensusNeeded)
+	if err != nil {
+		return status, err
+	}
+	k.setTxHashIndex(ctx, common.NormalizeEthHash(claim.EthereumTxHash), claim.EthereumLogIndex, oracleId)
+	return status, nil
+}
+
+// GetConsensusProgress returns the consensus a prophecy needs and the power of the validators that have claimed it
+func (k Keeper) GetConsensusProgress(ctx sdk.Context, prophecy oracle.Prophecy) oracle.ConsensusProgress {
+	return k.oracleKeeper.GetConsensusProgress(ctx, prophecy)
+}
+
+// ProcessSuccessfulClaim mints the coins of a claim that reached consensus to its receiver, unless they are above
+// a delay threshold and wait in the pending-release queue, or the circuit breaker or the mint limits hold them back
+// in the mint queue. Nothing is minted for items whose locked funds were already released on ethereum. The ethereum
+// amount of the claim is scaled with the token scales in force now, recording any dust. The post-mint actions of the
+// claim are recorded to run whenever its coins are minted.
+func (k Keeper) ProcessSuccessfulClaim(ctx sdk.Context, itemID string, claim string) sdk.Error {
+	oracleClaim, err := types.CreateOracleClaimFromOracleString(claim)
+	if err != nil {
+		return err
+	}
+	// The locked funds of an item that was already withdrawn on ethereum no longer back its coins
+	if k.IsWithdrawn(ctx, common.NormalizeEthHash(itemID)) {
+		return nil
+	}
+	amount, dust, err := k.ScaleAmount(ctx, oracleClaim.Amount)
+	if err != nil {
+		return err
+	}
+	if !dust.IsZero() {
+		k.SetDust(ctx, types.NewDust(common.NormalizeEthHash(itemID), dust, ctx.BlockHeight()))
+	}
+	receiverAddress := oracleClaim.CosmosReceiver
+	if len(oracleClaim.PostMintActions) > 0 {
+		k.SetPostMint(ctx, types.NewPostMint(common.NormalizeEthHash(itemID), receiverAddress,
+			oracleClaim.PostMintActions, ctx.BlockHeight()))
+	}
+	return k.delayOrMint(ctx, common.NormalizeEthHash(itemID), receiverAddress, amount)
+}
+
+// GetProphecyIDsByTxHash returns the ids of all prophecies that validators claimed were emitted by the given ethereum transaction
+func (k Keeper) GetProphecyIDsByTxHash(ctx sdk.Context, ethereumTxHash string) []string {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.GetTxHashPrefixKey(common.NormalizeEthHash(ethereumTxHash)))
+	defer iterator.Close()
+
+	var ids []string
+	for ; iterator.Valid(); iterator.Next() {
+		ids = append(ids, string(iterator.Value()))
+	}
+	return ids
+}
+
+func (k Keeper) setTxHashIndex(ctx sdk.Context, ethereumTxHash string, ethereumLogIndex uint64, id string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetTxHashKey(ethereumTxHash, ethereumLogIndex, id), []byte(id))
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle"
+)
+
+func TestProcessClaimKeyedByItemID(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddress := sdk.AccAddress(validatorAddresses[0])
+
+	claim := types.CreateTestEthClaim(t, accAddress, types.TestEthereumAddress, types.TestCoins)
+	status, err := keeper.ProcessClaim(ctx, claim)
+	require.NoError(t, err)
+	require.Equal(t, status.StatusText, oracle.PendingStatus)
+
+	prophecy, err := keeper.GetProphecy(ctx, types.TestItemID)
+	require.NoError(t, err)
+	require.Equal(t, prophecy.ID, common.NormalizeEthHash(types.TestItemID))
+
+	_, err = keeper.GetProphecy(ctx, types.AltTestItemID)
+	require.Error(t, err)
+}
+
+func TestGetProphecyIDsByTxHash(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 3, 4})
+
+	claim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, types.TestCoins)
+	_, err := keeper.ProcessClaim(ctx, claim)
+	require.NoError(t, err)
+
+	//A second lock emitted from the same transaction
+	altClaim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[1]), types.TestEthereumAddress, types.TestCoins)
+	altClaim.ItemID = types.AltTestItemID
+	altClaim.EthereumLogIndex = types.TestEthereumLogIndex + 1
+	_, err = keeper.ProcessClaim(ctx, altClaim)
+	require.NoError(t, err)
+
+	ids := keeper.GetProphecyIDsByTxHash(ctx, types.TestEthereumTxHash)
+	require.Equal(t, []string{common.NormalizeEthHash(types.TestItemID), common.NormalizeEthHash(types.AltTestItemID)}, ids)
+
+	require.Empty(t, keeper.GetProphecyIDsByTxHash(ctx, types.TestItemID))
+}
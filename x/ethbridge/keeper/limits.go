@@ -0,0 +1,165 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// IsMintingPaused returns whether the circuit breaker is holding back all minting
+func (k Keeper) IsMintingPaused(ctx sdk.Context) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.MintingPausedKey)
+}
+
+// SetMintingPaused trips or resets the circuit breaker
+func (k Keeper) SetMintingPaused(ctx sdk.Context, paused bool) {
+	store := ctx.KVStore(k.storeKey)
+	if paused {
+		store.Set(types.MintingPausedKey, []byte{0x01})
+	} else {
+		store.Delete(types.MintingPausedKey)
+	}
+}
+
+// GetQueuedMint returns the queued mint of a peggy item
+func (k Keeper) GetQueuedMint(ctx sdk.Context, itemID string) (types.QueuedMint, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetQueuedMintKey(itemID))
+	if bz == nil {
+		return types.QueuedMint{}, false
+	}
+	var mint types.QueuedMint
+	k.cdc.MustUnmarshalBinaryBare(bz, &mint)
+	return mint, true
+}
+
+// SetQueuedMint queues a successful claim until it is released
+func (k Keeper) SetQueuedMint(ctx sdk.Context, mint types.QueuedMint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetQueuedMintKey(mint.ItemID), k.cdc.MustMarshalBinaryBare(mint))
+}
+
+// GetQueuedMints returns every queued mint
+func (k Keeper) GetQueuedMints(ctx sdk.Context) types.QueuedMints {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.QueuedMintPrefix)
+	defer iterator.Close()
+
+	mints := types.QueuedMints{}
+	for ; iterator.Valid(); iterator.Next() {
+		var mint types.QueuedMint
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &mint)
+		mints = append(mints, mint)
+	}
+	return mints
+}
+
+// ReleaseQueuedMint mints the coins of a queued claim. Released mints count towards the mint limits but are never
+// held back by them; they cannot be released while minting is paused.
+func (k Keeper) ReleaseQueuedMint(ctx sdk.Context, itemID string) (types.QueuedMint, sdk.Error) {
+	if k.IsMintingPaused(ctx) {
+		return types.QueuedMint{}, types.ErrMintingPaused(k.Codespace())
+	}
+	mint, found := k.GetQueuedMint(ctx, itemID)
+	if !found {
+		return types.QueuedMint{}, types.ErrQueuedMintNotFound(k.Codespace())
+	}
+	if err := k.MintCoins(ctx, mint.ItemID, mint.Receiver, mint.Amount); err != nil {
+		return types.QueuedMint{}, err
+	}
+	k.recordMint(ctx, mint.ItemID, mint.Receiver, mint.Amount)
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedMintKey(itemID))
+	return mint, nil
+}
+
+// PruneMintRecords deletes the mint records that have fallen out of the mint window
+func (k Keeper) PruneMintRecords(ctx sdk.Context) {
+	start := ctx.BlockHeight() - k.GetParams(ctx).MintWindow + 1
+	if start <= 0 {
+		return
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.MintRecordPrefix, types.GetMintRecordHeightKey(start))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// mintOrQueue mints the coins of a successful claim, or queues them if minting is paused or they would exceed the
+// mint limits within the mint window
+func (k Keeper) mintOrQueue(ctx sdk.Context, itemID string, receiver sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	if _, found := k.GetMintedClaim(ctx, itemID); found {
+		return types.ErrAlreadyMinted(k.Codespace())
+	}
+	if k.IsMintingPaused(ctx) {
+		k.SetQueuedMint(ctx, types.NewQueuedMint(itemID, receiver, amount, types.QueueReasonPaused, ctx.BlockHeight()))
+		return nil
+	}
+	if k.exceedsMintLimits(ctx, receiver, amount) {
+		k.SetQueuedMint(ctx, types.NewQueuedMint(itemID, receiver, amount, types.QueueReasonRateLimited, ctx.BlockHeight()))
+		return nil
+	}
+	if err := k.MintCoins(ctx, itemID, receiver, amount); err != nil {
+		return err
+	}
+	k.recordMint(ctx, itemID, receiver, amount)
+	return nil
+}
+
+// exceedsMintLimits returns whether minting the amount to the receiver would take the coins minted within the mint
+// window over the limit of any denomination
+func (k Keeper) exceedsMintLimits(ctx sdk.Context, receiver sdk.AccAddress, amount sdk.Coins) bool {
+	params := k.GetParams(ctx)
+	if len(params.MintLimits) == 0 {
+		return false
+	}
+	denomTotal, receiverTotal := k.mintedInWindow(ctx, params.MintWindow, receiver)
+	for _, coin := range amount {
+		limit, found := params.MintLimits.Get(coin.Denom)
+		if !found {
+			continue
+		}
+		if limit.DenomLimit.IsPositive() && denomTotal.AmountOf(coin.Denom).Add(coin.Amount).GT(limit.DenomLimit) {
+			return true
+		}
+		if limit.ReceiverLimit.IsPositive() && receiverTotal.AmountOf(coin.Denom).Add(coin.Amount).GT(limit.ReceiverLimit) {
+			return true
+		}
+	}
+	return false
+}
+
+// mintedInWindow sums the coins minted within the mint window ending at the current block, in total and to the receiver
+func (k Keeper) mintedInWindow(ctx sdk.Context, window int64, receiver sdk.AccAddress) (total sdk.Coins, toReceiver sdk.Coins) {
+	start := ctx.BlockHeight() - window + 1
+	if start < 0 {
+		start = 0
+	}
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.GetMintRecordHeightKey(start), types.GetMintRecordHeightKey(ctx.BlockHeight()+1))
+	defer iterator.Close()
+
+	total, toReceiver = sdk.Coins{}, sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.MintRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		total = total.Add(record.Amount)
+		if record.Receiver.Equals(receiver) {
+			toReceiver = toReceiver.Add(record.Amount)
+		}
+	}
+	return total, toReceiver
+}
+
+func (k Keeper) recordMint(ctx sdk.Context, itemID string, receiver sdk.AccAddress, amount sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	record := types.MintRecord{Receiver: receiver, Amount: amount}
+	store.Set(types.GetMintRecordKey(ctx.BlockHeight(), itemID), k.cdc.MustMarshalBinaryBare(record))
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestPausedMintsAreQueued(t *testing.T) {
+	ctx, _, keeper, bankKeeper, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	amount, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+
+	keeper.SetMintingPaused(ctx, true)
+	err = keeper.mintOrQueue(ctx, types.TestItemID, receiver, amount)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsZero())
+
+	mint, found := keeper.GetQueuedMint(ctx, types.TestItemID)
+	require.True(t, found)
+	require.Equal(t, types.QueueReasonPaused, mint.Reason)
+	require.True(t, mint.Amount.IsEqual(amount))
+
+	//Queued mints cannot be released while minting is paused
+	_, err = keeper.ReleaseQueuedMint(ctx, types.TestItemID)
+	require.Error(t, err)
+
+	keeper.SetMintingPaused(ctx, false)
+	_, err = keeper.ReleaseQueuedMint(ctx, types.TestItemID)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(amount))
+	require.Len(t, keeper.GetQueuedMints(ctx), 0)
+
+	//A released mint cannot be released again
+	_, err = keeper.ReleaseQueuedMint(ctx, types.TestItemID)
+	require.Error(t, err)
+	require.NoError(t, SupplyInvariant(keeper)(ctx))
+}
+
+func TestMintLimits(t *testing.T) {
+	ctx, _, keeper, bankKeeper, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	otherReceiver, err := sdk.AccAddressFromBech32(types.TestValidator)
+	require.NoError(t, err)
+	amount, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.MintWindow = 10
+	params.MintLimits = types.MintLimits{types.NewMintLimit("ethereum", sdk.NewInt(25), sdk.NewInt(15))}
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(1)
+
+	//The first mint is within both limits
+	err = keeper.mintOrQueue(ctx, types.TestItemID, receiver, amount)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(amount))
+
+	//A second mint to the same receiver exceeds the receiver limit
+	err = keeper.mintOrQueue(ctx, types.AltTestItemID, receiver, amount)
+	require.NoError(t, err)
+	mint, found := keeper.GetQueuedMint(ctx, types.AltTestItemID)
+	require.True(t, found)
+	require.Equal(t, types.QueueReasonRateLimited, mint.Reason)
+
+	//Another receiver can still be minted to until the denom limit is reached
+	thirdItemID := "0xab85e2ceaa7d100af2f07cac01365f3777153a4e004342dca5db44e731b9d463"
+	fourthItemID := "0xab85e2ceaa7d100af2f07cac01365f3777153a4e004342dca5db44e731b9d464"
+	err = keeper.mintOrQueue(ctx, thirdItemID, otherReceiver, amount)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, otherReceiver).IsEqual(amount))
+	err = keeper.mintOrQueue(ctx, fourthItemID, otherReceiver, sdk.NewCoins(sdk.NewInt64Coin("ethereum", 5)))
+	require.NoError(t, err)
+	_, found = keeper.GetQueuedMint(ctx, fourthItemID)
+	require.False(t, found)
+
+	//Once the earlier mints leave the window the receiver can be minted to again
+	ctx = ctx.WithBlockHeight(11)
+	keeper.PruneMintRecords(ctx)
+	fifthItemID := "0xab85e2ceaa7d100af2f07cac01365f3777153a4e004342dca5db44e731b9d465"
+	err = keeper.mintOrQueue(ctx, fifthItemID, receiver, amount)
+	require.NoError(t, err)
+	_, found = keeper.GetQueuedMint(ctx, fifthItemID)
+	require.False(t, found)
+
+	//Releasing the queued mint is not held back by the limits
+	_, err = keeper.ReleaseQueuedMint(ctx, types.AltTestItemID)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 30))))
+	require.NoError(t, SupplyInvariant(keeper)(ctx))
+}
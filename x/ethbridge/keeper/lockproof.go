@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+	ethheaders "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
+)
+
+// VerifyLockProof checks that a receipt proven against a confirmed header of the ethheaders light client holds, at
+// logIndex, a LogLock log of the peggy contract that matches the claim. The claim must name the transaction of the
+// receipt and the index of the log in its block, and carry the denomination the params map the locked token to.
+func (k Keeper) VerifyLockProof(ctx sdk.Context, claim types.EthBridgeClaim, proof ethheaders.ReceiptProof,
+	logIndex uint64) sdk.Error {
+	params := k.GetParams(ctx)
+	peggyContract := params.PeggyContract
+	if peggyContract == "" {
+		return types.ErrPeggyContractUnset(k.Codespace())
+	}
+	proven, err := k.ethHeadersKeeper.VerifyReceiptProof(ctx, proof)
+	if err != nil {
+		return err
+	}
+	if gethCommon.HexToHash(claim.EthereumTxHash) != proven.TxHash {
+		return types.ErrInvalidLockProof(k.Codespace(), fmt.Sprintf("transaction %s does not match the proven %s",
+			claim.EthereumTxHash, proven.TxHash.Hex()))
+	}
+	if logIndex >= uint64(len(proven.Receipt.Logs)) {
+		return types.ErrInvalidLockProof(k.Codespace(), fmt.Sprintf("receipt has no log %d", logIndex))
+	}
+	if claim.EthereumLogIndex != proven.LogOffset+logIndex {
+		return types.ErrInvalidLockProof(k.Codespace(), fmt.Sprintf("log index %d does not match the proven %d",
+			claim.EthereumLogIndex, proven.LogOffset+logIndex))
+	}
+	lockLog, decodeErr := types.DecodeLockLog(proven.Receipt.Logs[logIndex], peggyContract)
+	if decodeErr != nil {
+		return types.ErrInvalidLockProof(k.Codespace(), decodeErr.Error())
+	}
+	if matchErr := lockLog.Matches(claim, params.TokenDenoms); matchErr != nil {
+		return types.ErrInvalidLockProof(k.Codespace(), matchErr.Error())
+	}
+	return nil
+}
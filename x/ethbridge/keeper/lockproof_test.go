@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+	ethheaders "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
+)
+
+const testPeggyContract = "0x3f5dab653144958ff6309d1a2ba0b8f4bbd8b7b5"
+
+func TestVerifyLockProof(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	claim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, types.TestCoins)
+
+	//The receipt holds an unrelated log before the LogLock log of the claim
+	data, err := types.EncodeLockLogData(types.LockLog{
+		ID:    gethCommon.HexToHash(claim.ItemID),
+		From:  gethCommon.HexToAddress(claim.EthereumSender),
+		To:    []byte(claim.CosmosReceiver.String()),
+		Token: gethCommon.Address{},
+		Value: big.NewInt(10),
+		Nonce: big.NewInt(int64(claim.Nonce)),
+	})
+	require.NoError(t, err)
+	//An earlier transaction of the block emitted two logs
+	receipts := ethtypes.Receipts{
+		{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 30000,
+			Logs: []*ethtypes.Log{{Address: gethCommon.HexToAddress("0x01")}, {Address: gethCommon.HexToAddress("0x01")}}},
+		{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 80000,
+			Logs: []*ethtypes.Log{
+				{Address: gethCommon.HexToAddress(types.TestEthereumAddress), Topics: []gethCommon.Hash{types.LockEventTopic}, Data: data},
+				{Address: gethCommon.HexToAddress(testPeggyContract), Topics: []gethCommon.Hash{types.LockEventTopic}, Data: data},
+			}},
+	}
+	transactions := ethtypes.Transactions{
+		ethtypes.NewTransaction(0, gethCommon.HexToAddress("0x01"), big.NewInt(0), 30000, big.NewInt(1), nil),
+		ethtypes.NewTransaction(1, gethCommon.HexToAddress(testPeggyContract), big.NewInt(10), 50000, big.NewInt(1), nil),
+	}
+	root, nodes := ethheaders.CreateTestReceiptProof(t, receipts, 1)
+	txRoot, txNodes := ethheaders.CreateTestTransactionProof(t, transactions, 1)
+	header := ethheaders.CreateTestAnchorHeader(0, root)
+	header.TxHash = txRoot
+	keeper.ethHeadersKeeper.ImportHeaders(ctx, ethheaders.Headers{ethheaders.NewHeader(header, sdk.ZeroInt())})
+	keeper.ethHeadersKeeper.SetParams(ctx, ethheaders.NewParams(ethheaders.ConsensusRuleProofOfStake, sdk.ZeroInt(), 0))
+	proof := ethheaders.NewReceiptProof(header.Hash().Hex(), 1, nodes, txNodes)
+	claim.EthereumTxHash = transactions[1].Hash().Hex()
+	claim.EthereumLogIndex = 3
+
+	//Proven claims are disabled until the peggy contract is set
+	verifyErr := keeper.VerifyLockProof(ctx, claim, proof, 1)
+	require.Equal(t, types.CodePeggyContractUnset, verifyErr.Code())
+	params := keeper.GetParams(ctx)
+	params.PeggyContract = testPeggyContract
+	keeper.SetParams(ctx, params)
+
+	require.NoError(t, keeper.VerifyLockProof(ctx, claim, proof, 1))
+
+	//The log must exist, be emitted by the peggy contract and match the claim
+	verifyErr = keeper.VerifyLockProof(ctx, claim, proof, 0)
+	require.Equal(t, types.CodeInvalidLockProof, verifyErr.Code())
+	verifyErr = keeper.VerifyLockProof(ctx, claim, proof, 2)
+	require.Equal(t, types.CodeInvalidLockProof, verifyErr.Code())
+	otherClaim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, types.AltTestCoins)
+	verifyErr = keeper.VerifyLockProof(ctx, otherClaim, proof, 1)
+	require.Equal(t, types.CodeInvalidLockProof, verifyErr.Code())
+	otherClaim = types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.AltTestEthereumAddress, types.TestCoins)
+	verifyErr = keeper.VerifyLockProof(ctx, otherClaim, proof, 1)
+	require.Equal(t, types.CodeInvalidLockProof, verifyErr.Code())
+
+	//The claim must name the proven transaction and the index of the log in the block
+	otherClaim = claim
+	otherClaim.EthereumTxHash = transactions[0].Hash().Hex()
+	verifyErr = keeper.VerifyLockProof(ctx, otherClaim, proof, 1)
+	require.Equal(t, types.CodeInvalidLockProof, verifyErr.Code())
+	otherClaim = claim
+	otherClaim.EthereumLogIndex = 1
+	verifyErr = keeper.VerifyLockProof(ctx, otherClaim, proof, 1)
+	require.Equal(t, types.CodeInvalidLockProof, verifyErr.Code())
+
+	//The receipt must be proven against a stored header
+	verifyErr = keeper.VerifyLockProof(ctx, claim, ethheaders.NewReceiptProof(types.TestEthereumTxHash, 1, nodes, txNodes), 1)
+	require.Equal(t, ethheaders.CodeHeaderNotFound, verifyErr.Code())
+}
+
+func TestVerifyLockProofToken(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	token := gethCommon.HexToAddress("0x6b175474e89094c44da98b954eedeac495271d0f")
+
+	//The log locks 10 of an ERC20 token
+	ethClaim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, types.TestCoins)
+	data, err := types.EncodeLockLogData(types.LockLog{
+		ID:    gethCommon.HexToHash(ethClaim.ItemID),
+		From:  gethCommon.HexToAddress(ethClaim.EthereumSender),
+		To:    []byte(ethClaim.CosmosReceiver.String()),
+		Token: token,
+		Value: big.NewInt(10),
+		Nonce: big.NewInt(int64(ethClaim.Nonce)),
+	})
+	require.NoError(t, err)
+	receipts := ethtypes.Receipts{
+		{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 50000,
+			Logs: []*ethtypes.Log{
+				{Address: gethCommon.HexToAddress(testPeggyContract), Topics: []gethCommon.Hash{types.LockEventTopic}, Data: data},
+			}},
+	}
+	transactions := ethtypes.Transactions{
+		ethtypes.NewTransaction(0, gethCommon.HexToAddress(testPeggyContract), big.NewInt(0), 50000, big.NewInt(1), nil),
+	}
+	root, nodes := ethheaders.CreateTestReceiptProof(t, receipts, 0)
+	txRoot, txNodes := ethheaders.CreateTestTransactionProof(t, transactions, 0)
+	header := ethheaders.CreateTestAnchorHeader(0, root)
+	header.TxHash = txRoot
+	keeper.ethHeadersKeeper.ImportHeaders(ctx, ethheaders.Headers{ethheaders.NewHeader(header, sdk.ZeroInt())})
+	keeper.ethHeadersKeeper.SetParams(ctx, ethheaders.NewParams(ethheaders.ConsensusRuleProofOfStake, sdk.ZeroInt(), 0))
+	proof := ethheaders.NewReceiptProof(header.Hash().Hex(), 0, nodes, txNodes)
+	params := keeper.GetParams(ctx)
+	params.PeggyContract = testPeggyContract
+	keeper.SetParams(ctx, params)
+
+	claim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, "10dai")
+	for _, c := range []*types.EthBridgeClaim{&ethClaim, &claim} {
+		c.EthereumTxHash = transactions[0].Hash().Hex()
+		c.EthereumLogIndex = 0
+	}
+
+	//Locks of tokens that are not bridged can't be claimed
+	verifyErr := keeper.VerifyLockProof(ctx, claim, proof, 0)
+	require.Equal(t, types.CodeInvalidLockProof, verifyErr.Code())
+
+	params.TokenDenoms = types.TokenDenoms{types.NewTokenDenom(token.Hex(), "dai")}
+	keeper.SetParams(ctx, params)
+	require.NoError(t, keeper.VerifyLockProof(ctx, claim, proof, 0))
+
+	//The token can't be claimed as ether
+	verifyErr = keeper.VerifyLockProof(ctx, ethClaim, proof, 0)
+	require.Equal(t, types.CodeInvalidLockProof, verifyErr.Code())
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// GetParams returns the current params of the ethbridge module
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the params of the ethbridge module
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// IsAdmin returns whether an address is the ethbridge admin
+func (k Keeper) IsAdmin(ctx sdk.Context, address sdk.AccAddress) bool {
+	admin := k.GetParams(ctx).Admin
+	return !admin.Empty() && admin.Equals(address)
+}
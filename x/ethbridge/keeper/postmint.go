@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// GetPostMint returns the post-mint actions recorded for a peggy item
+func (k Keeper) GetPostMint(ctx sdk.Context, itemID string) (types.PostMint, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetPostMintKey(itemID))
+	if bz == nil {
+		return types.PostMint{}, false
+	}
+	var postMint types.PostMint
+	k.cdc.MustUnmarshalBinaryBare(bz, &postMint)
+	return postMint, true
+}
+
+// SetPostMint records the post-mint actions of a peggy item
+func (k Keeper) SetPostMint(ctx sdk.Context, postMint types.PostMint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetPostMintKey(postMint.ItemID), k.cdc.MustMarshalBinaryBare(postMint))
+}
+
+// GetPostMints returns the post-mint actions of every peggy item
+func (k Keeper) GetPostMints(ctx sdk.Context) types.PostMints {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.PostMintPrefix)
+	defer iterator.Close()
+
+	postMints := types.PostMints{}
+	for ; iterator.Valid(); iterator.Next() {
+		var postMint types.PostMint
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &postMint)
+		postMints = append(postMints, postMint)
+	}
+	return postMints
+}
+
+// executePostMint runs the pending post-mint actions of an item on the coins its receiver was just sent, and returns
+// the coins left with the receiver. The actions run atomically: if any of them fails none is applied, the coins stay
+// with the receiver and the failure is recorded. The mint itself is never undone.
+func (k Keeper) executePostMint(ctx sdk.Context, itemID string, received sdk.Coins) sdk.Coins {
+	postMint, found := k.GetPostMint(ctx, itemID)
+	if !found || postMint.Status != types.PostMintStatusPending {
+		return received
+	}
+	remaining := received
+	cacheCtx, write := ctx.CacheContext()
+	if left, err := k.executePostMintActions(cacheCtx, postMint.Receiver, received, postMint.Actions); err != nil {
+		postMint.Status = types.PostMintStatusFailed
+		postMint.Log = err.Result().Log
+		ctx.Logger().Info(fmt.Sprintf("post-mint actions of item %s failed: %s", itemID, postMint.Log))
+	} else {
+		write()
+		postMint.Status = types.PostMintStatusExecuted
+		remaining = left
+	}
+	postMint.Height = ctx.BlockHeight()
+	k.SetPostMint(ctx, postMint)
+	return remaining
+}
+
+func (k Keeper) executePostMintActions(ctx sdk.Context, receiver sdk.AccAddress, received sdk.Coins,
+	actions types.PostMintActions) (sdk.Coins, sdk.Error) {
+	remaining := received
+	for _, action := range actions {
+		var err sdk.Error
+		switch action.Type {
+		case types.PostMintActionSend:
+			remaining, err = k.postMintSend(ctx, receiver, remaining, action)
+		case types.PostMintActionDelegate:
+			remaining, err = k.postMintDelegate(ctx, receiver, remaining, action)
+		default:
+			err = types.ErrInvalidPostMintAction(k.Codespace(), fmt.Sprintf("unknown action %s", action.Type))
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return remaining, nil
+}
+
+// postMintSend sends the amount of the action, or all that is left of the mint, on to the action's address
+func (k Keeper) postMintSend(ctx sdk.Context, receiver sdk.AccAddress, remaining sdk.Coins,
+	action types.PostMintAction) (sdk.Coins, sdk.Error) {
+	amount := action.Amount
+	if amount.Empty() {
+		amount = remaining
+	}
+	if err := k.checkPostMintAmount(remaining, amount); err != nil {
+		return nil, err
+	}
+	if _, err := k.bankKeeper.SendCoins(ctx, receiver, action.ToAddress, amount); err != nil {
+		return nil, err
+	}
+	return remaining.Sub(amount), nil
+}
+
+// postMintDelegate delegates the amount of the action, or all of the bond denomination that is left of the mint,
+// from the receiver to the action's validator through the staking keeper
+func (k Keeper) postMintDelegate(ctx sdk.Context, receiver sdk.AccAddress, remaining sdk.Coins,
+	action types.PostMintAction) (sdk.Coins, sdk.Error) {
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	amount := action.Amount
+	if amount.Empty() {
+		amount = sdk.NewCoins(sdk.NewCoin(bondDenom, remaining.AmountOf(bondDenom)))
+	}
+	if len(amount) == 1 && amount[0].Denom != bondDenom {
+		return nil, types.ErrInvalidPostMintAction(k.Codespace(), fmt.Sprintf("only %s can be delegated", bondDenom))
+	}
+	if err := k.checkPostMintAmount(remaining, amount); err != nil {
+		return nil, err
+	}
+	validator, found := k.stakingKeeper.GetValidator(ctx, action.ValidatorAddress)
+	if !found {
+		return nil, types.ErrInvalidPostMintAction(k.Codespace(),
+			fmt.Sprintf("validator %s not found", action.ValidatorAddress))
+	}
+	if _, err := k.stakingKeeper.Delegate(ctx, receiver, amount[0].Amount, validator, true); err != nil {
+		return nil, err
+	}
+	return remaining.Sub(amount), nil
+}
+
+func (k Keeper) checkPostMintAmount(remaining sdk.Coins, amount sdk.Coins) sdk.Error {
+	if amount.IsZero() {
+		return types.ErrInvalidPostMintAction(k.Codespace(), "nothing is left of the mint")
+	}
+	if !remaining.IsAllGTE(amount) {
+		return types.ErrInvalidPostMintAction(k.Codespace(),
+			fmt.Sprintf("%s exceeds the %s left of the mint", amount, remaining))
+	}
+	return nil
+}
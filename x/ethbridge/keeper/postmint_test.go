@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestPostMintActions(t *testing.T) {
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	forwardee := sdk.AccAddress(validatorAddresses[1])
+	forwardeeCoins := bankKeeper.GetCoins(ctx, forwardee)
+
+	//Send part of the mint on and delegate the rest of the bond denomination
+	ethClaim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, "10stake,4ethereum")
+	ethClaim.PostMintActions = types.PostMintActions{
+		types.NewPostMintAction(types.PostMintActionSend, forwardee, nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 3))),
+		types.NewPostMintAction(types.PostMintActionDelegate, nil, validatorAddresses[0], nil),
+	}
+	_, _, claimString := types.CreateOracleClaimFromEthClaim(keeper.cdc, ethClaim)
+	err = keeper.ProcessSuccessfulClaim(ctx, types.TestItemID, claimString)
+	require.NoError(t, err)
+
+	postMint, found := keeper.GetPostMint(ctx, types.TestItemID)
+	require.True(t, found)
+	require.Equal(t, types.PostMintStatusExecuted, postMint.Status)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 4))))
+	require.True(t, bankKeeper.GetCoins(ctx, forwardee).IsEqual(forwardeeCoins.Add(sdk.NewCoins(sdk.NewInt64Coin("stake", 3)))))
+	delegation, found := keeper.stakingKeeper.GetDelegation(ctx, receiver, validatorAddresses[0])
+	require.True(t, found)
+	require.True(t, delegation.Shares.IsPositive())
+
+	//A failing action leaves every coin with the receiver
+	ethClaim = types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, "5stake")
+	ethClaim.ItemID = types.AltTestItemID
+	ethClaim.PostMintActions = types.PostMintActions{
+		types.NewPostMintAction(types.PostMintActionSend, forwardee, nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 2))),
+		types.NewPostMintAction(types.PostMintActionSend, forwardee, nil, sdk.NewCoins(sdk.NewInt64Coin("stake", 4))),
+	}
+	_, _, claimString = types.CreateOracleClaimFromEthClaim(keeper.cdc, ethClaim)
+	err = keeper.ProcessSuccessfulClaim(ctx, types.AltTestItemID, claimString)
+	require.NoError(t, err)
+
+	postMint, found = keeper.GetPostMint(ctx, types.AltTestItemID)
+	require.True(t, found)
+	require.Equal(t, types.PostMintStatusFailed, postMint.Status)
+	require.NotEmpty(t, postMint.Log)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 4), sdk.NewInt64Coin("stake", 5))))
+	require.True(t, bankKeeper.GetCoins(ctx, forwardee).IsEqual(forwardeeCoins.Add(sdk.NewCoins(sdk.NewInt64Coin("stake", 3)))))
+	require.NoError(t, SupplyInvariant(keeper)(ctx))
+}
+
+func TestDelegateActionStakingSupply(t *testing.T) {
+	ctx, accountKeeper, keeper, bankKeeper, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	stakingInvariants := staking.SupplyInvariants(keeper.stakingKeeper, noFees{}, noDistribution{}, accountKeeper)
+	require.NoError(t, stakingInvariants(ctx))
+
+	//Minted coins of the bond denomination are loose tokens of the staking pool, and can be delegated
+	ethClaim := types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, "10stake")
+	ethClaim.PostMintActions = types.PostMintActions{
+		types.NewPostMintAction(types.PostMintActionDelegate, nil, validatorAddresses[0], sdk.NewCoins(sdk.NewInt64Coin("stake", 4))),
+	}
+	_, _, claimString := types.CreateOracleClaimFromEthClaim(keeper.cdc, ethClaim)
+	require.NoError(t, keeper.ProcessSuccessfulClaim(ctx, types.TestItemID, claimString))
+
+	postMint, found := keeper.GetPostMint(ctx, types.TestItemID)
+	require.True(t, found)
+	require.Equal(t, types.PostMintStatusExecuted, postMint.Status)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("stake", 6))))
+	validator, found := keeper.stakingKeeper.GetValidator(ctx, validatorAddresses[0])
+	require.True(t, found)
+	require.Equal(t, sdk.TokensFromTendermintPower(3).AddRaw(4), validator.Tokens)
+	require.NoError(t, stakingInvariants(ctx))
+
+	//Other denominations can't be delegated
+	ethClaim = types.CreateTestEthClaim(t, sdk.AccAddress(validatorAddresses[0]), types.TestEthereumAddress, "10ethereum")
+	ethClaim.ItemID = types.AltTestItemID
+	ethClaim.PostMintActions = types.PostMintActions{
+		types.NewPostMintAction(types.PostMintActionDelegate, nil, validatorAddresses[0], sdk.NewCoins(sdk.NewInt64Coin("ethereum", 10))),
+	}
+	_, _, claimString = types.CreateOracleClaimFromEthClaim(keeper.cdc, ethClaim)
+	require.NoError(t, keeper.ProcessSuccessfulClaim(ctx, types.AltTestItemID, claimString))
+
+	postMint, found = keeper.GetPostMint(ctx, types.AltTestItemID)
+	require.True(t, found)
+	require.Equal(t, types.PostMintStatusFailed, postMint.Status)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 10), sdk.NewInt64Coin("stake", 6))))
+
+	//Burnt coins of the bond denomination leave the staking pool
+	require.NoError(t, keeper.BurnCoins(ctx, receiver, sdk.NewCoins(sdk.NewInt64Coin("stake", 6))))
+	require.NoError(t, stakingInvariants(ctx))
+	require.NoError(t, SupplyInvariant(keeper)(ctx))
+}
+
+// noFees and noDistribution stand in for the fee collection and distribution keepers the staking invariants read
+type noFees struct{}
+
+func (noFees) GetCollectedFees(sdk.Context) sdk.Coins { return sdk.Coins{} }
+
+type noDistribution struct{}
+
+func (noDistribution) GetFeePoolCommunityCoins(sdk.Context) sdk.DecCoins { return sdk.DecCoins{} }
+
+func (noDistribution) GetValidatorOutstandingRewardsCoins(sdk.Context, sdk.ValAddress) sdk.DecCoins {
+	return sdk.DecCoins{}
+}
+
+func TestParseRecipient(t *testing.T) {
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+
+	//A plain receiver has no actions
+	parsed, actions, err := types.ParseRecipient([]byte(types.TestAddress))
+	require.NoError(t, err)
+	require.Equal(t, receiver, parsed)
+	require.Len(t, actions, 0)
+
+	//Memos round trip through the recipient bytes
+	memoActions := types.PostMintActions{types.NewPostMintAction(types.PostMintActionSend, receiver, nil, nil)}
+	parsed, actions, err = types.ParseRecipient(types.FormatRecipient(receiver, memoActions))
+	require.NoError(t, err)
+	require.Equal(t, receiver, parsed)
+	require.True(t, memoActions.Equal(actions))
+
+	//Unknown actions, unknown fields and actions missing their target are rejected
+	for _, memo := range []string{
+		`{"actions":[{"type":"swap"}]}`,
+		`{"actions":[],"callback":"x"}`,
+		`{"actions":[{"type":"delegate"}]}`,
+	} {
+		_, _, err = types.ParseRecipient([]byte(types.TestAddress + types.MemoSeparator + memo))
+		require.Error(t, err, memo)
+	}
+}
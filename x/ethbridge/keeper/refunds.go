@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// ProcessFailedClaim places a peggy item whose lock prophecy failed in the refund queue. The sender and amount
+// are taken from the claim with the most power behind it.
+func (k Keeper) ProcessFailedClaim(ctx sdk.Context, itemID string) (types.Refund, sdk.Error) {
+	prophecy, err := k.GetProphecy(ctx, itemID)
+	if err != nil {
+		return types.Refund{}, err
+	}
+	claim, _, _ := prophecy.FindHighestClaim(ctx, k.stakingKeeper)
+	oracleClaim, err := types.CreateOracleClaimFromOracleString(claim)
+	if err != nil {
+		return types.Refund{}, err
+	}
+	refund := types.NewRefund(common.NormalizeEthHash(itemID), oracleClaim.EthereumSender, oracleClaim.EthereumTxHash,
+		oracleClaim.Amount, ctx.BlockHeight())
+	k.SetRefund(ctx, refund)
+	return refund, nil
+}
+
+// GetRefund returns the refund of a peggy item
+func (k Keeper) GetRefund(ctx sdk.Context, itemID string) (types.Refund, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetRefundKey(itemID))
+	if bz == nil {
+		return types.Refund{}, false
+	}
+	var refund types.Refund
+	k.cdc.MustUnmarshalBinaryBare(bz, &refund)
+	return refund, true
+}
+
+// SetRefund places a peggy item in the refund queue
+func (k Keeper) SetRefund(ctx sdk.Context, refund types.Refund) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetRefundKey(refund.ItemID), k.cdc.MustMarshalBinaryBare(refund))
+}
+
+// GetRefunds returns every refund that has not been unlocked yet
+func (k Keeper) GetRefunds(ctx sdk.Context) types.Refunds {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.RefundPrefix)
+	defer iterator.Close()
+
+	refunds := types.Refunds{}
+	for ; iterator.Valid(); iterator.Next() {
+		var refund types.Refund
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &refund)
+		refunds = append(refunds, refund)
+	}
+	return refunds
+}
+
+func (k Keeper) deleteRefund(ctx sdk.Context, itemID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetRefundKey(itemID))
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// ScaleAmount converts an ethereum amount to the coins minted for it with the token scales of the params, and
+// returns the remainder left as dust. Claims keep the ethereum amount, so validators agree on it whatever scales
+// were set when they claimed, and it is scaled once when the claim succeeds.
+func (k Keeper) ScaleAmount(ctx sdk.Context, amount sdk.Coins) (sdk.Coins, sdk.Coins, sdk.Error) {
+	return k.GetParams(ctx).TokenScales.ToCosmos(amount)
+}
+
+// GetDust returns the dust recorded for a peggy item
+func (k Keeper) GetDust(ctx sdk.Context, itemID string) (types.Dust, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetDustKey(itemID))
+	if bz == nil {
+		return types.Dust{}, false
+	}
+	var dust types.Dust
+	k.cdc.MustUnmarshalBinaryBare(bz, &dust)
+	return dust, true
+}
+
+// SetDust records the dust of a peggy item
+func (k Keeper) SetDust(ctx sdk.Context, dust types.Dust) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetDustKey(dust.ItemID), k.cdc.MustMarshalBinaryBare(dust))
+}
+
+// GetDusts returns the dust of every peggy item
+func (k Keeper) GetDusts(ctx sdk.Context) types.Dusts {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.DustPrefix)
+	defer iterator.Close()
+
+	dusts := types.Dusts{}
+	for ; iterator.Valid(); iterator.Next() {
+		var dust types.Dust
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &dust)
+		dusts = append(dusts, dust)
+	}
+	return dusts
+}
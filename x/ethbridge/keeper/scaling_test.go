@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestScaleAmount(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	validator := sdk.AccAddress(validatorAddresses[0])
+
+	params := keeper.GetParams(ctx)
+	params.TokenScales = types.TokenScales{
+		types.NewTokenScale("ethereum", "ueth", 18, 6, types.DustRuleRoundDown),
+		types.NewTokenScale("wbtc", "usat", 8, 8, types.DustRuleReject),
+		types.NewTokenScale("dai", "udai", 18, 6, types.DustRuleReject),
+	}
+	keeper.SetParams(ctx, params)
+
+	//Round down keeps the remainder as dust
+	scaled, dust, err := keeper.ScaleAmount(ctx, parseCoins(t, "3000000000123ethereum,5wbtc"))
+	require.NoError(t, err)
+	require.True(t, scaled.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ueth", 3), sdk.NewInt64Coin("usat", 5))))
+	require.True(t, dust.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 123))))
+
+	//Reject refuses any remainder, and every rule refuses amounts below one unit
+	_, _, err = keeper.ScaleAmount(ctx, parseCoins(t, "1000000000001dai"))
+	require.Error(t, err)
+	_, _, err = keeper.ScaleAmount(ctx, parseCoins(t, "999999999999ethereum"))
+	require.Error(t, err)
+
+	//Unscaled denominations pass through, but claims cannot name a scaled cosmos denomination
+	scaled, dust, err = keeper.ScaleAmount(ctx, parseCoins(t, "7stake"))
+	require.NoError(t, err)
+	require.True(t, scaled.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("stake", 7))))
+	require.True(t, dust.IsZero())
+	_, _, err = keeper.ScaleAmount(ctx, parseCoins(t, "7ueth"))
+	require.Error(t, err)
+
+	//Malformed amounts are rejected instead of panicking, and scaled coins come out sorted
+	_, _, err = keeper.ScaleAmount(ctx, sdk.Coins{sdk.NewInt64Coin("wbtc", 5), sdk.NewInt64Coin("wbtc", 5)})
+	require.Equal(t, sdk.CodeInvalidCoins, err.Code())
+	scaled, _, err = keeper.ScaleAmount(ctx, parseCoins(t, "3000000000000ethereum,5tttt"))
+	require.NoError(t, err)
+	require.True(t, scaled.IsValid())
+
+	//Outgoing transfers convert back to ethereum units
+	transfers := keeper.AddOutgoingTransfers(ctx, validator, types.TestEthereumAddress, sdk.NewCoins(sdk.NewInt64Coin("ueth", 3)))
+	require.Len(t, transfers, 1)
+	require.Equal(t, sdk.NewInt64Coin("ethereum", 3000000000000), transfers[0].EthereumAmount)
+}
+
+func parseCoins(t *testing.T, coins string) sdk.Coins {
+	amount, err := sdk.ParseCoins(coins)
+	require.NoError(t, err)
+	return amount
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+// GetSupply returns the bridged supply of a denomination
+func (k Keeper) GetSupply(ctx sdk.Context, denom string) types.Supply {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetSupplyKey(denom))
+	if bz == nil {
+		return types.NewSupply(denom)
+	}
+	var supply types.Supply
+	k.cdc.MustUnmarshalBinaryBare(bz, &supply)
+	return supply
+}
+
+// SetSupply sets the bridged supply of a denomination
+func (k Keeper) SetSupply(ctx sdk.Context, supply types.Supply) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetSupplyKey(supply.Denom), k.cdc.MustMarshalBinaryBare(supply))
+}
+
+// GetSupplies returns the bridged supply of every denomination that has been minted
+func (k Keeper) GetSupplies(ctx sdk.Context) types.Supplies {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.SupplyPrefix)
+	defer iterator.Close()
+
+	supplies := types.Supplies{}
+	for ; iterator.Valid(); iterator.Next() {
+		var supply types.Supply
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &supply)
+		supplies = append(supplies, supply)
+	}
+	return supplies
+}
+
+// GetMintedClaim returns the coins minted for a successful claim on a peggy item
+func (k Keeper) GetMintedClaim(ctx sdk.Context, itemID string) (sdk.Coins, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetMintedClaimKey(itemID))
+	if bz == nil {
+		return nil, false
+	}
+	var amount sdk.Coins
+	k.cdc.MustUnmarshalBinaryBare(bz, &amount)
+	return amount, true
+}
+
+// IterateMintedClaims iterates over the coins minted for every successful claim
+func (k Keeper) IterateMintedClaims(ctx sdk.Context, fn func(itemID string, amount sdk.Coins) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MintedClaimPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Coins
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &amount)
+		if fn(string(iterator.Key()[len(types.MintedClaimPrefix):]), amount) {
+			break
+		}
+	}
+}
+
+// MintCoins mints the coins of a successful claim through the ethbridge module account, sends them to the receiver
+// less the bridge fee and records them in the bridged supply. The bridge fee is routed to the fee pool and
+// distributed to the validators whose claims matched the final claim. Any post-mint actions of the item then run on
+// the coins the receiver got.
+func (k Keeper) MintCoins(ctx sdk.Context, itemID string, receiver sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	if _, found := k.GetMintedClaim(ctx, itemID); found {
+		return types.ErrAlreadyMinted(k.Codespace())
+	}
+	_, _, err := k.bankKeeper.AddCoins(ctx, types.ModuleAddress, amount)
+	if err != nil {
+		return err
+	}
+	fee := k.GetParams(ctx).BridgeFees.Compute(amount)
+	received := amount.Sub(fee)
+	if !received.IsZero() {
+		_, err = k.bankKeeper.SendCoins(ctx, types.ModuleAddress, receiver, received)
+		if err != nil {
+			return err
+		}
+	}
+	if !fee.IsZero() {
+		_, err = k.bankKeeper.SendCoins(ctx, types.ModuleAddress, types.FeePoolAddress, fee)
+		if err != nil {
+			return err
+		}
+		err = k.distributeBridgeFee(ctx, itemID, fee)
+		if err != nil {
+			return err
+		}
+	}
+	for _, coin := range amount {
+		k.SetSupply(ctx, k.GetSupply(ctx, coin.Denom).Mint(coin.Amount))
+	}
+	// Like the mint module, report minted coins of the bond denomination to staking as loose tokens, so they can be
+	// delegated
+	if bonded := amount.AmountOf(k.stakingKeeper.BondDenom(ctx)); bonded.IsPositive() {
+		k.stakingKeeper.InflateSupply(ctx, bonded)
+	}
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetMintedClaimKey(itemID), k.cdc.MustMarshalBinaryBare(amount))
+	credited := k.executePostMint(ctx, itemID, received)
+	k.setCredit(ctx, itemID, types.NewCredit(receiver, credited))
+	return nil
+}
+
+// BurnCoins takes bridged coins from the sender into the ethbridge module account and burns them
+func (k Keeper) BurnCoins(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coins) sdk.Error {
+	for _, coin := range amount {
+		if k.GetSupply(ctx, coin.Denom).Outstanding.LT(coin.Amount) {
+			return types.ErrInsufficientBridgedSupply(k.Codespace())
+		}
+	}
+	_, err := k.bankKeeper.SendCoins(ctx, sender, types.ModuleAddress, amount)
+	if err != nil {
+		return err
+	}
+	_, _, err = k.bankKeeper.SubtractCoins(ctx, types.ModuleAddress, amount)
+	if err != nil {
+		return err
+	}
+	for _, coin := range amount {
+		k.SetSupply(ctx, k.GetSupply(ctx, coin.Denom).Burn(coin.Amount))
+	}
+	if bonded := amount.AmountOf(k.stakingKeeper.BondDenom(ctx)); bonded.IsPositive() {
+		k.stakingKeeper.InflateSupply(ctx, bonded.Neg())
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestMintAndBurnCoins(t *testing.T) {
+	ctx, _, keeper, bankKeeper, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	amount, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+
+	//Mint through the module account
+	err = keeper.MintCoins(ctx, types.TestItemID, receiver, amount)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(amount))
+	require.True(t, bankKeeper.GetCoins(ctx, types.ModuleAddress).IsZero())
+
+	supply := keeper.GetSupply(ctx, "ethereum")
+	require.Equal(t, sdk.NewInt(10), supply.Minted)
+	require.Equal(t, sdk.NewInt(10), supply.Outstanding)
+	require.True(t, supply.Burned.IsZero())
+
+	minted, found := keeper.GetMintedClaim(ctx, types.TestItemID)
+	require.True(t, found)
+	require.True(t, minted.IsEqual(amount))
+
+	//The same item cannot be minted twice
+	err = keeper.MintCoins(ctx, types.TestItemID, receiver, amount)
+	require.Error(t, err)
+
+	//Burn part of the supply
+	burnAmount := sdk.NewCoins(sdk.NewInt64Coin("ethereum", 4))
+	err = keeper.BurnCoins(ctx, receiver, burnAmount)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 6))))
+
+	supply = keeper.GetSupply(ctx, "ethereum")
+	require.Equal(t, sdk.NewInt(10), supply.Minted)
+	require.Equal(t, sdk.NewInt(4), supply.Burned)
+	require.Equal(t, sdk.NewInt(6), supply.Outstanding)
+
+	//Coins which were never bridged cannot be burned
+	err = keeper.BurnCoins(ctx, receiver, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	require.Error(t, err)
+
+	require.NoError(t, SupplyInvariant(keeper)(ctx))
+}
+
+func TestSupplyInvariant(t *testing.T) {
+	ctx, _, keeper, _, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	amount, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+
+	err = keeper.MintCoins(ctx, types.TestItemID, receiver, amount)
+	require.NoError(t, err)
+	require.NoError(t, SupplyInvariant(keeper)(ctx))
+
+	//Break the invariant by recording supply that no claim accounts for
+	keeper.SetSupply(ctx, keeper.GetSupply(ctx, "ethereum").Mint(sdk.NewInt(1)))
+	require.Error(t, SupplyInvariant(keeper)(ctx))
+}
@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingKeeperLib "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+	ethheaders "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/keeper"
+	ethheaderstypes "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
+	oracleKeeperLib "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/keeper"
+	oracletypes "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// CreateTestKeepers greates an ethbridge Keeper, AccountKeeper, BankKeeper and Context backed by an oracle with bonded validators of the given powers
+func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorPowers []int64) (sdk.Context, auth.AccountKeeper, Keeper, bank.Keeper, []sdk.ValAddress, sdk.Error) {
+	keyEthBridge := sdk.NewKVStoreKey(types.StoreKey)
+	keyEthHeaders := sdk.NewKVStoreKey(ethheaderstypes.StoreKey)
+	keyOracle := sdk.NewKVStoreKey(oracletypes.StoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
+	tkeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(tkeyStaking, sdk.StoreTypeTransient, nil)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyEthBridge, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyEthHeaders, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "testchainid"}, false, log.NewNopLogger())
+	ctx = ctx.WithConsensusParams(
+		&abci.ConsensusParams{
+			Validator: &abci.ValidatorParams{
+				PubKeyTypes: []string{tmtypes.ABCIPubKeyTypeEd25519},
+			},
+		},
+	)
+	cdc := oracleKeeperLib.MakeTestCodec()
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+
+	accountKeeper := auth.NewAccountKeeper(
+		cdc,    // amino codec
+		keyAcc, // target store
+		pk.Subspace(auth.DefaultParamspace),
+		auth.ProtoBaseAccount, // prototype
+	)
+
+	bankKeeper := bank.NewBaseKeeper(
+		accountKeeper,
+		pk.Subspace(bank.DefaultParamspace),
+		bank.DefaultCodespace,
+	)
+
+	stakingKeeper := staking.NewKeeper(cdc, keyStaking, tkeyStaking, bankKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+	stakingKeeper.SetPool(ctx, staking.InitialPool())
+	stakingKeeper.SetParams(ctx, staking.DefaultParams())
+
+	oracleKeeper, keeperErr := oracleKeeperLib.NewKeeper(stakingKeeper, keyOracle, cdc, oracletypes.DefaultCodespace, consensusNeeded)
+
+	ethHeadersKeeper := ethheaders.NewKeeper(oracleKeeper, stakingKeeper, keyEthHeaders, pk.Subspace(ethheaderstypes.DefaultParamspace), cdc, ethheaderstypes.DefaultCodespace)
+	ethHeadersKeeper.SetParams(ctx, ethheaderstypes.DefaultParams())
+
+	keeper := NewKeeper(oracleKeeper, bankKeeper, stakingKeeper, ethHeadersKeeper, keyEthBridge, pk.Subspace(types.DefaultParamspace), cdc, types.DefaultCodespace)
+	keeper.SetParams(ctx, types.DefaultParams())
+
+	//construct the validators
+	_, valAddresses := oracleKeeperLib.CreateTestAddrs(len(validatorPowers))
+	publicKeys := oracleKeeperLib.CreateTestPubKeys(len(validatorPowers))
+
+	// the validators bond all of their coins, so the staking pool holds them as bonded tokens
+	pool := stakingKeeper.GetPool(ctx)
+	for _, power := range validatorPowers {
+		pool.NotBondedTokens = pool.NotBondedTokens.Add(sdk.TokensFromTendermintPower(power))
+	}
+	for i, power := range validatorPowers {
+		validator := staking.NewValidator(valAddresses[i], publicKeys[i], staking.Description{})
+		validator.Status = sdk.Bonded
+		validator.Tokens = sdk.ZeroInt()
+		tokens := sdk.TokensFromTendermintPower(power)
+		validator, pool, _ = validator.AddTokensFromDel(pool, tokens)
+		stakingKeeper.SetPool(ctx, pool)
+		stakingKeeperLib.TestingUpdateValidator(stakingKeeper, ctx, validator, true)
+	}
+
+	return ctx, accountKeeper, keeper, bankKeeper, valAddresses, keeperErr
+}
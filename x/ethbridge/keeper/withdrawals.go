@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle"
+)
+
+// ProcessReleaseClaim forwards an EthBridgeReleaseClaim to the oracle, on a prophecy separate from the item's lock
+func (k Keeper) ProcessReleaseClaim(ctx sdk.Context, claim types.EthBridgeReleaseClaim) (oracle.Status, sdk.Error) {
+	oracleId, validator, claimString := types.CreateOracleClaimFromReleaseClaim(claim)
+	return k.oracleKeeper.ProcessClaim(ctx, oracleId, validator, claimString)
+}
+
+// ProcessSuccessfulReleaseClaim records the release of a peggy item's locked funds once validators agree on it,
+// and reconciles it with the coins claimed for the item
+func (k Keeper) ProcessSuccessfulReleaseClaim(ctx sdk.Context, itemID string, claim string) sdk.Error {
+	oracleClaim, err := types.CreateOracleReleaseClaimFromOracleString(claim)
+	if err != nil {
+		return err
+	}
+	return k.RecordWithdrawal(ctx, types.NewWithdrawal(common.NormalizeEthHash(itemID), oracleClaim, ctx.BlockHeight()))
+}
+
+// RecordWithdrawal records a peggy item as released on ethereum. The coins of the item that are still queued or
+// waiting out the release delay are frozen so they are never minted. The coins already minted for a withdrawn item
+// are clawed back from the receiver, up to what the item credited it and as far as its balance allows, and the rest
+// is recorded as a deficit. Minted
+// items that are unlocked are not clawed back, as peggy only unlocks them once their coins have been burned.
+// Refunds of failed items are complete once their funds are released.
+func (k Keeper) RecordWithdrawal(ctx sdk.Context, withdrawal types.Withdrawal) sdk.Error {
+	itemID := withdrawal.ItemID
+	k.deleteRefund(ctx, itemID)
+	if release, found := k.GetPendingRelease(ctx, itemID); found {
+		k.deletePendingRelease(ctx, release)
+		withdrawal.Frozen = release.Amount
+	} else if mint, found := k.GetQueuedMint(ctx, itemID); found {
+		store := ctx.KVStore(k.storeKey)
+		store.Delete(types.GetQueuedMintKey(itemID))
+		withdrawal.Frozen = mint.Amount
+	} else if minted, found := k.GetMintedClaim(ctx, itemID); found && withdrawal.Kind == types.ReleaseKindWithdraw {
+		clawedBack, err := k.clawBack(ctx, itemID)
+		if err != nil {
+			return err
+		}
+		withdrawal.ClawedBack = clawedBack
+		withdrawal.Deficit = minted.Sub(clawedBack)
+	}
+	k.SetWithdrawal(ctx, withdrawal)
+	return nil
+}
+
+// GetWithdrawal returns the withdrawal of a peggy item
+func (k Keeper) GetWithdrawal(ctx sdk.Context, itemID string) (types.Withdrawal, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetWithdrawalKey(itemID))
+	if bz == nil {
+		return types.Withdrawal{}, false
+	}
+	var withdrawal types.Withdrawal
+	k.cdc.MustUnmarshalBinaryBare(bz, &withdrawal)
+	return withdrawal, true
+}
+
+// SetWithdrawal sets the withdrawal of a peggy item
+func (k Keeper) SetWithdrawal(ctx sdk.Context, withdrawal types.Withdrawal) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetWithdrawalKey(withdrawal.ItemID), k.cdc.MustMarshalBinaryBare(withdrawal))
+}
+
+// IsWithdrawn returns whether the locked funds of a peggy item were released on ethereum
+func (k Keeper) IsWithdrawn(ctx sdk.Context, itemID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetWithdrawalKey(itemID))
+}
+
+// GetWithdrawals returns every withdrawal
+func (k Keeper) GetWithdrawals(ctx sdk.Context) types.Withdrawals {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.WithdrawalPrefix)
+	defer iterator.Close()
+
+	withdrawals := types.Withdrawals{}
+	for ; iterator.Valid(); iterator.Next() {
+		var withdrawal types.Withdrawal
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &withdrawal)
+		withdrawals = append(withdrawals, withdrawal)
+	}
+	return withdrawals
+}
+
+// GetDeficitReport returns the coins minted for withdrawn items that could not be clawed back
+func (k Keeper) GetDeficitReport(ctx sdk.Context) types.DeficitReport {
+	return types.NewDeficitReport(k.GetWithdrawals(ctx))
+}
+
+// clawBack burns up to the coins the mint of an item credited its receiver from the receiver's balance. The bridge
+// fee and the coins moved on by post-mint actions never were the receiver's, and coins of the same denomination
+// the receiver got from other items are left alone.
+func (k Keeper) clawBack(ctx sdk.Context, itemID string) (sdk.Coins, sdk.Error) {
+	credit, found := k.getCredit(ctx, itemID)
+	if !found {
+		return sdk.NewCoins(), nil
+	}
+	balance := k.bankKeeper.GetCoins(ctx, credit.Receiver)
+
+	clawedBack := sdk.NewCoins()
+	for _, coin := range credit.Amount {
+		available := balance.AmountOf(coin.Denom)
+		if available.GT(coin.Amount) {
+			available = coin.Amount
+		}
+		if available.IsPositive() {
+			clawedBack = clawedBack.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, available)))
+		}
+	}
+	if clawedBack.IsZero() {
+		return clawedBack, nil
+	}
+	if err := k.BurnCoins(ctx, credit.Receiver, clawedBack); err != nil {
+		return nil, err
+	}
+	return clawedBack, nil
+}
+
+// getCredit returns the coins the mint of a peggy item left with its receiver
+func (k Keeper) getCredit(ctx sdk.Context, itemID string) (types.Credit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCreditKey(itemID))
+	if bz == nil {
+		return types.Credit{}, false
+	}
+	var credit types.Credit
+	k.cdc.MustUnmarshalBinaryBare(bz, &credit)
+	return credit, true
+}
+
+func (k Keeper) setCredit(ctx sdk.Context, itemID string, credit types.Credit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetCreditKey(itemID), k.cdc.MustMarshalBinaryBare(credit))
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
+)
+
+func TestWithdrawalFreezesUnmintedCoins(t *testing.T) {
+	ctx, _, keeper, bankKeeper, _, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	amount, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+
+	params := keeper.GetParams(ctx)
+	params.ReleaseDelay = 5
+	params.DelayThresholds = sdk.NewCoins(sdk.NewInt64Coin("ethereum", 9))
+	keeper.SetParams(ctx, params)
+	ctx = ctx.WithBlockHeight(1)
+
+	//A transfer waiting out the release delay is frozen when it is withdrawn
+	err = keeper.delayOrMint(ctx, types.TestItemID, receiver, amount)
+	require.NoError(t, err)
+	withdrawClaim := types.OracleReleaseClaim{
+		EthereumTxHash:   types.TestEthereumTxHash,
+		Kind:             types.ReleaseKindWithdraw,
+		EthereumReceiver: types.TestEthereumAddress,
+		Amount:           amount,
+	}
+	err = keeper.RecordWithdrawal(ctx, types.NewWithdrawal(types.TestItemID, withdrawClaim, ctx.BlockHeight()))
+	require.NoError(t, err)
+	_, found := keeper.GetPendingRelease(ctx, types.TestItemID)
+	require.False(t, found)
+	withdrawal, found := keeper.GetWithdrawal(ctx, types.TestItemID)
+	require.True(t, found)
+	require.True(t, withdrawal.Frozen.IsEqual(amount))
+	require.True(t, withdrawal.Deficit.IsZero())
+
+	//A queued mint is frozen as well
+	keeper.SetMintingPaused(ctx, true)
+	err = keeper.mintOrQueue(ctx, types.AltTestItemID, receiver, amount)
+	require.NoError(t, err)
+	unlockClaim := withdrawClaim
+	unlockClaim.Kind = types.ReleaseKindUnlock
+	err = keeper.RecordWithdrawal(ctx, types.NewWithdrawal(types.AltTestItemID, unlockClaim, ctx.BlockHeight()))
+	require.NoError(t, err)
+	_, found = keeper.GetQueuedMint(ctx, types.AltTestItemID)
+	require.False(t, found)
+
+	//Lock claims that succeed after the withdrawal mint nothing
+	keeper.SetMintingPaused(ctx, false)
+	ethClaim := types.CreateTestEthClaim(t, receiver, types.TestEthereumAddress, types.TestCoins)
+	_, _, claimString := types.CreateOracleClaimFromEthClaim(keeper.cdc, ethClaim)
+	err = keeper.ProcessSuccessfulClaim(ctx, types.TestItemID, claimString)
+	require.NoError(t, err)
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsZero())
+	require.Len(t, keeper.GetWithdrawals(ctx), 2)
+	require.True(t, keeper.GetDeficitReport(ctx).Deficit.IsZero())
+}
+
+func TestClawBackLimitedToItemCredit(t *testing.T) {
+	ctx, _, keeper, bankKeeper, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	other := sdk.AccAddress(validatorAddresses[0])
+	amount := sdk.NewCoins(sdk.NewInt64Coin("ethereum", 10))
+
+	params := keeper.GetParams(ctx)
+	params.BridgeFees = types.BridgeFees{types.NewBridgeFee("ethereum", sdk.NewDecWithPrec(1, 1), sdk.ZeroInt())}
+	keeper.SetParams(ctx, params)
+
+	//The withdrawn item pays a fee of 1 and sends 4 on to another address, leaving 5 with the receiver
+	actions := types.PostMintActions{
+		types.NewPostMintAction(types.PostMintActionSend, other, nil, sdk.NewCoins(sdk.NewInt64Coin("ethereum", 4))),
+	}
+	keeper.SetPostMint(ctx, types.NewPostMint(types.TestItemID, receiver, actions, ctx.BlockHeight()))
+	require.NoError(t, keeper.MintCoins(ctx, types.TestItemID, receiver, amount))
+
+	//The receiver holds coins of the same denomination from another item
+	require.NoError(t, keeper.MintCoins(ctx, types.AltTestItemID, receiver, amount))
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 14))))
+
+	withdrawClaim := types.OracleReleaseClaim{
+		EthereumTxHash:   types.TestEthereumTxHash,
+		Kind:             types.ReleaseKindWithdraw,
+		EthereumReceiver: types.TestEthereumAddress,
+		Amount:           amount,
+	}
+	err = keeper.RecordWithdrawal(ctx, types.NewWithdrawal(types.TestItemID, withdrawClaim, ctx.BlockHeight()))
+	require.NoError(t, err)
+
+	//Only the item's credit is clawed back, the coins of the other item stay with the receiver
+	withdrawal, found := keeper.GetWithdrawal(ctx, types.TestItemID)
+	require.True(t, found)
+	require.True(t, withdrawal.ClawedBack.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 5))))
+	require.True(t, withdrawal.Deficit.IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 5))))
+	require.True(t, bankKeeper.GetCoins(ctx, receiver).IsEqual(sdk.NewCoins(sdk.NewInt64Coin("ethereum", 9))))
+	require.NoError(t, SupplyInvariant(keeper)(ctx))
+}
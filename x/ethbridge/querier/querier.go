@@ -2,54 +2,209 @@ package querier
 
 import (
 	"fmt"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/keeper"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
-	keep "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/keeper"
 	oracletypes "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/types"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
-//query endpoints supported by the oracle Querier
+// query endpoints supported by the oracle Querier
 const (
-	QueryEthProphecy = "prophecies"
+	QueryEthProphecy     = "prophecies"
+	QueryEthProphecyByTx = "txProphecies"
+	QuerySupply          = "supply"
+	QueryParams          = "params"
+	QueryQueuedMints     = "queuedMints"
+	QueryMintingPaused   = "mintingPaused"
+	QueryPendingReleases = "pendingReleases"
+	QueryFeeRewards      = "feeRewards"
+	QueryFeeders         = "feeders"
+	QueryWithdrawals     = "withdrawals"
+	QueryDeficitReport   = "deficitReport"
+	QueryRefunds         = "refunds"
+	QueryEthereumKeys    = "ethereumKeys"
+
+	QueryOutgoingTransfers = "outgoingTransfers"
+	QueryValsets           = "valsets"
+	QueryAttestations      = "attestations"
+	QueryDust              = "dust"
+	QueryPostMints         = "postMints"
 )
 
 // NewQuerier is the module level router for state queries
-func NewQuerier(keeper keep.Keeper, cdc *codec.Codec, codespace sdk.CodespaceType) sdk.Querier {
+func NewQuerier(keeper keeper.Keeper, cdc *codec.Codec, codespace sdk.CodespaceType) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
 		switch path[0] {
 		case QueryEthProphecy:
 			return queryEthProphecy(ctx, cdc, req, keeper, codespace)
+		case QueryEthProphecyByTx:
+			return queryEthProphecyByTx(ctx, cdc, req, keeper, codespace)
+		case QuerySupply:
+			return querySupply(ctx, cdc, req, keeper)
+		case QueryParams:
+			return marshalResponse(cdc, keeper.GetParams(ctx))
+		case QueryQueuedMints:
+			return marshalResponse(cdc, keeper.GetQueuedMints(ctx))
+		case QueryMintingPaused:
+			return marshalResponse(cdc, keeper.IsMintingPaused(ctx))
+		case QueryPendingReleases:
+			return marshalResponse(cdc, keeper.GetPendingReleases(ctx))
+		case QueryFeeRewards:
+			return queryFeeRewards(ctx, cdc, req, keeper)
+		case QueryFeeders:
+			return marshalResponse(cdc, keeper.GetFeeders(ctx))
+		case QueryWithdrawals:
+			return marshalResponse(cdc, keeper.GetWithdrawals(ctx))
+		case QueryDeficitReport:
+			return marshalResponse(cdc, keeper.GetDeficitReport(ctx))
+		case QueryDust:
+			return marshalResponse(cdc, keeper.GetDusts(ctx))
+		case QueryPostMints:
+			return marshalResponse(cdc, keeper.GetPostMints(ctx))
+		case QueryRefunds:
+			return marshalResponse(cdc, keeper.GetRefunds(ctx))
+		case QueryEthereumKeys:
+			return queryEthereumKeys(ctx, cdc, req, keeper)
+		case QueryOutgoingTransfers:
+			return marshalResponse(cdc, keeper.GetOutgoingTransfers(ctx))
+		case QueryValsets:
+			return marshalResponse(cdc, keeper.GetValsets(ctx))
+		case QueryAttestations:
+			return queryAttestations(ctx, cdc, req, keeper, codespace)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown ethbridge query endpoint")
 		}
 	}
 }
 
-func queryEthProphecy(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keep.Keeper, codespace sdk.CodespaceType) (res []byte, err sdk.Error) {
+func queryEthProphecy(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper, codespace sdk.CodespaceType) (res []byte, err sdk.Error) {
 	var params types.QueryEthProphecyParams
 
 	errRes := cdc.UnmarshalJSON(req.Data, &params)
 	if errRes != nil {
-		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
 	}
 
-	id := strconv.Itoa(params.Nonce) + params.EthereumSender
-	prophecy, err := keeper.GetProphecy(ctx, id)
+	response, err := getEthProphecyResponse(ctx, keeper, params.ItemID, codespace)
 	if err != nil {
+		return []byte{}, err
+	}
+
+	bz, err3 := codec.MarshalJSONIndent(cdc, response)
+	if err3 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryEthProphecyByTx(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper, codespace sdk.CodespaceType) (res []byte, err sdk.Error) {
+	var params types.QueryEthProphecyByTxParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
+	}
+
+	ids := keeper.GetProphecyIDsByTxHash(ctx, params.EthereumTxHash)
+	if len(ids) == 0 {
 		return []byte{}, oracletypes.ErrProphecyNotFound(codespace)
 	}
 
-	bridgeClaims, err2 := MapOracleClaimsToEthBridgeClaims(params.Nonce, params.EthereumSender, prophecy.ValidatorClaims, types.CreateEthClaimFromOracleString)
-	if err2 != nil {
-		return []byte{}, err2
+	response := make(types.QueryEthProphecyByTxResponse, len(ids))
+	for i, id := range ids {
+		prophecyResponse, err := getEthProphecyResponse(ctx, keeper, id, codespace)
+		if err != nil {
+			return []byte{}, err
+		}
+		response[i] = prophecyResponse
+	}
+
+	bz, err3 := codec.MarshalJSONIndent(cdc, response)
+	if err3 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func querySupply(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper) (res []byte, err sdk.Error) {
+	var params types.QuerySupplyParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
+	}
+
+	var response interface{}
+	if params.Denom == "" {
+		response = keeper.GetSupplies(ctx)
+	} else {
+		response = keeper.GetSupply(ctx, params.Denom)
+	}
+
+	bz, err3 := codec.MarshalJSONIndent(cdc, response)
+	if err3 != nil {
+		panic("could not marshal result to JSON")
+	}
+
+	return bz, nil
+}
+
+func queryFeeRewards(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper) (res []byte, err sdk.Error) {
+	var params types.QueryFeeRewardsParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
 	}
 
-	response := types.NewQueryEthProphecyResponse(prophecy.ID, prophecy.Status, bridgeClaims)
+	if params.Validator.Empty() {
+		return marshalResponse(cdc, keeper.GetAllFeeRewards(ctx))
+	}
+	return marshalResponse(cdc, keeper.GetFeeRewards(ctx, params.Validator))
+}
+
+func queryEthereumKeys(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper) (res []byte, err sdk.Error) {
+	var params types.QueryEthereumKeysParams
 
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
+	}
+
+	if params.Validator.Empty() {
+		return marshalResponse(cdc, keeper.GetEthereumKeys(ctx))
+	}
+	keys := types.EthereumKeys{}
+	if key, found := keeper.GetEthereumKey(ctx, params.Validator); found {
+		keys = append(keys, key)
+	}
+	return marshalResponse(cdc, keys)
+}
+
+func queryAttestations(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper, codespace sdk.CodespaceType) (res []byte, err sdk.Error) {
+	var params types.QueryAttestationsParams
+
+	errRes := cdc.UnmarshalJSON(req.Data, &params)
+	if errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
+	}
+
+	if params.Nonce == 0 {
+		return marshalResponse(cdc, keeper.GetAttestations(ctx, params.Kind))
+	}
+	attestation, found := keeper.GetAttestation(ctx, params.Kind, params.Nonce)
+	if !found {
+		return []byte{}, types.ErrAttestationNotFound(codespace)
+	}
+	return marshalResponse(cdc, attestation)
+}
+
+func marshalResponse(cdc *codec.Codec, response interface{}) (res []byte, err sdk.Error) {
 	bz, err3 := codec.MarshalJSONIndent(cdc, response)
 	if err3 != nil {
 		panic("could not marshal result to JSON")
@@ -58,7 +213,22 @@ func queryEthProphecy(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery,
 	return bz, nil
 }
 
-func MapOracleClaimsToEthBridgeClaims(nonce int, ethereumSender string, oracleValidatorClaims map[string]string, f func(int, string, sdk.ValAddress, string) (types.EthBridgeClaim, sdk.Error)) ([]types.EthBridgeClaim, sdk.Error) {
+func getEthProphecyResponse(ctx sdk.Context, keeper keeper.Keeper, itemID string, codespace sdk.CodespaceType) (types.QueryEthProphecyResponse, sdk.Error) {
+	prophecy, err := keeper.GetProphecy(ctx, itemID)
+	if err != nil {
+		return types.QueryEthProphecyResponse{}, oracletypes.ErrProphecyNotFound(codespace)
+	}
+
+	bridgeClaims, err := MapOracleClaimsToEthBridgeClaims(prophecy.ID, prophecy.ValidatorClaims, types.CreateEthClaimFromOracleString)
+	if err != nil {
+		return types.QueryEthProphecyResponse{}, err
+	}
+
+	progress := keeper.GetConsensusProgress(ctx, prophecy)
+	return types.NewQueryEthProphecyResponse(prophecy.ID, prophecy.Status, progress, bridgeClaims), nil
+}
+
+func MapOracleClaimsToEthBridgeClaims(itemID string, oracleValidatorClaims map[string]string, f func(string, sdk.ValAddress, string) (types.EthBridgeClaim, sdk.Error)) ([]types.EthBridgeClaim, sdk.Error) {
 	mappedClaims := make([]types.EthBridgeClaim, len(oracleValidatorClaims))
 	i := 0
 	for validatorBech32, validatorClaim := range oracleValidatorClaims {
@@ -66,7 +236,7 @@ func MapOracleClaimsToEthBridgeClaims(nonce int, ethereumSender string, oracleVa
 		if parseErr != nil {
 			return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse claim: %s", parseErr))
 		}
-		mappedClaim, err := f(nonce, ethereumSender, validatorAddress, validatorClaim)
+		mappedClaim, err := f(itemID, validatorAddress, validatorClaim)
 		if err != nil {
 			return nil, err
 		}
@@ -9,12 +9,21 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	keeperLib "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/keeper"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/types"
-	keeperLib "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/keeper"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle"
 )
 
 var (
 	prophecyID0 = "0"
+
+	//The progress of a prophecy claimed by the power 3 validator out of a total power of 10
+	testProgress = oracle.ConsensusProgress{
+		ConsensusNeeded:   sdk.NewDecWithPrec(7, 1),
+		HighestClaimPower: 3,
+		TotalClaimsPower:  3,
+		TotalPower:        10,
+	}
 )
 
 func TestNewQuerier(t *testing.T) {
@@ -39,13 +48,12 @@ func TestQueryEthProphecy(t *testing.T) {
 	ctx, _, keeper, _, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
 	accAddress := sdk.AccAddress(validatorAddresses[0])
 	initialEthBridgeClaim := types.CreateTestEthClaim(t, accAddress, types.TestEthereumAddress, types.TestCoins)
-	oracleId, validator, claimText := types.CreateOracleClaimFromEthClaim(cdc, initialEthBridgeClaim)
-	_, err := keeper.ProcessClaim(ctx, oracleId, validator, claimText)
+	_, err := keeper.ProcessClaim(ctx, initialEthBridgeClaim)
 	require.Nil(t, err)
 
-	testResponse := types.CreateTestQueryEthProphecyResponse(cdc, t, accAddress)
+	testResponse := types.CreateTestQueryEthProphecyResponse(cdc, t, accAddress, testProgress)
 
-	bz, err2 := cdc.MarshalJSON(types.NewQueryEthProphecyParams(types.TestNonce, types.TestEthereumAddress))
+	bz, err2 := cdc.MarshalJSON(types.NewQueryEthProphecyParams(types.TestItemID))
 	require.Nil(t, err2)
 
 	query := abci.RequestQuery{
@@ -70,7 +78,7 @@ func TestQueryEthProphecy(t *testing.T) {
 
 	// Test error with nonexistent request
 	query.Data = bz[:len(bz)-1]
-	bz2, err6 := cdc.MarshalJSON(types.NewQueryEthProphecyParams(12, "badEthereumAddress"))
+	bz2, err6 := cdc.MarshalJSON(types.NewQueryEthProphecyParams(types.AltTestItemID))
 	require.Nil(t, err6)
 
 	query2 := abci.RequestQuery{
@@ -81,3 +89,69 @@ func TestQueryEthProphecy(t *testing.T) {
 	_, err7 := queryEthProphecy(ctx, cdc, query2, keeper, types.DefaultCodespace)
 	require.NotNil(t, err7)
 }
+
+func TestQueryEthProphecyByTx(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper, _, validatorAddresses, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	accAddress := sdk.AccAddress(validatorAddresses[0])
+	initialEthBridgeClaim := types.CreateTestEthClaim(t, accAddress, types.TestEthereumAddress, types.TestCoins)
+	_, err := keeper.ProcessClaim(ctx, initialEthBridgeClaim)
+	require.Nil(t, err)
+
+	testResponse := types.QueryEthProphecyByTxResponse{types.CreateTestQueryEthProphecyResponse(cdc, t, accAddress, testProgress)}
+
+	bz, err2 := cdc.MarshalJSON(types.NewQueryEthProphecyByTxParams(types.TestEthereumTxHash))
+	require.Nil(t, err2)
+
+	query := abci.RequestQuery{
+		Path: "/custom/ethbridge/txProphecies",
+		Data: bz,
+	}
+
+	//Test query
+	res, err3 := queryEthProphecyByTx(ctx, cdc, query, keeper, types.DefaultCodespace)
+	require.Nil(t, err3)
+
+	var ethProphecyResp types.QueryEthProphecyByTxResponse
+	err4 := cdc.UnmarshalJSON(res, &ethProphecyResp)
+	require.Nil(t, err4)
+	require.True(t, reflect.DeepEqual(ethProphecyResp, testResponse))
+
+	// Test error with unknown transaction
+	bz2, err5 := cdc.MarshalJSON(types.NewQueryEthProphecyByTxParams(types.TestItemID))
+	require.Nil(t, err5)
+	query.Data = bz2
+
+	_, err6 := queryEthProphecyByTx(ctx, cdc, query, keeper, types.DefaultCodespace)
+	require.NotNil(t, err6)
+}
+
+func TestQuerySupply(t *testing.T) {
+	cdc := codec.New()
+	ctx, _, keeper, _, _, _ := keeperLib.CreateTestKeepers(t, 0.7, []int64{3, 7})
+	receiver, err := sdk.AccAddressFromBech32(types.TestAddress)
+	require.NoError(t, err)
+	amount, err := sdk.ParseCoins(types.TestCoins)
+	require.NoError(t, err)
+	require.Nil(t, keeper.MintCoins(ctx, types.TestItemID, receiver, amount))
+
+	//Query a single denomination
+	bz, err := cdc.MarshalJSON(types.NewQuerySupplyParams("ethereum"))
+	require.Nil(t, err)
+	res, err2 := querySupply(ctx, cdc, abci.RequestQuery{Data: bz}, keeper)
+	require.Nil(t, err2)
+
+	var supply types.Supply
+	require.Nil(t, cdc.UnmarshalJSON(res, &supply))
+	require.Equal(t, keeper.GetSupply(ctx, "ethereum"), supply)
+
+	//Query all denominations
+	bz, err = cdc.MarshalJSON(types.NewQuerySupplyParams(""))
+	require.Nil(t, err)
+	res, err2 = querySupply(ctx, cdc, abci.RequestQuery{Data: bz}, keeper)
+	require.Nil(t, err2)
+
+	var supplies types.Supplies
+	require.Nil(t, cdc.UnmarshalJSON(res, &supplies))
+	require.Equal(t, types.Supplies{supply}, supplies)
+}
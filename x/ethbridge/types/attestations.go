@@ -0,0 +1,284 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Kinds of attestations validators sign with their ethereum keys
+const (
+	AttestationKindTransfer = "transfer"
+	AttestationKindValset   = "valset"
+)
+
+// IsValidAttestationKind returns whether kind is a known attestation kind
+func IsValidAttestationKind(kind string) bool {
+	return kind == AttestationKindTransfer || kind == AttestationKindValset
+}
+
+var (
+	bytes32Type, _   = abi.NewType("bytes32", "", nil)
+	uint256Type, _   = abi.NewType("uint256", "", nil)
+	addressType, _   = abi.NewType("address", "", nil)
+	stringType, _    = abi.NewType("string", "", nil)
+	addressesType, _ = abi.NewType("address[]", "", nil)
+	uint256sType, _  = abi.NewType("uint256[]", "", nil)
+
+	transferCheckpointArgs = abi.Arguments{{Type: bytes32Type}, {Type: uint256Type}, {Type: addressType},
+		{Type: stringType}, {Type: uint256Type}}
+	valsetCheckpointArgs = abi.Arguments{{Type: bytes32Type}, {Type: uint256Type}, {Type: addressesType},
+		{Type: uint256sType}}
+)
+
+// checkpointMethod returns a kind as the bytes32 that separates the checkpoints of each kind
+func checkpointMethod(kind string) [32]byte {
+	var method [32]byte
+	copy(method[:], kind)
+	return method
+}
+
+// OutgoingTransfer is a transfer of burned bridged coins to an ethereum address, which the validators attest to.
+// EthereumAmount is the burned amount converted back to the token's ethereum denomination and base units.
+type OutgoingTransfer struct {
+	Nonce            uint64         `json:"nonce"`
+	Sender           sdk.AccAddress `json:"sender"`
+	EthereumReceiver string         `json:"ethereum_receiver"`
+	Amount           sdk.Coin       `json:"amount"`
+	EthereumAmount   sdk.Coin       `json:"ethereum_amount"`
+	Height           int64          `json:"height"`
+}
+
+// NewOutgoingTransfer returns a new OutgoingTransfer
+func NewOutgoingTransfer(nonce uint64, sender sdk.AccAddress, ethereumReceiver string, amount sdk.Coin,
+	ethereumAmount sdk.Coin, height int64) OutgoingTransfer {
+	return OutgoingTransfer{
+		Nonce:            nonce,
+		Sender:           sender,
+		EthereumReceiver: ethereumReceiver,
+		Amount:           amount,
+		EthereumAmount:   ethereumAmount,
+		Height:           height,
+	}
+}
+
+// Checkpoint returns the hash validators sign for the transfer, which an ethereum contract recomputes as
+// keccak256(abi.encode(bytes32("transfer"), nonce, receiver, denom, amount)) over the ethereum amount
+func (transfer OutgoingTransfer) Checkpoint() []byte {
+	bz, err := transferCheckpointArgs.Pack(checkpointMethod(AttestationKindTransfer), new(big.Int).SetUint64(transfer.Nonce),
+		gethCommon.HexToAddress(transfer.EthereumReceiver), transfer.EthereumAmount.Denom,
+		transfer.EthereumAmount.Amount.BigInt())
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256(bz)
+}
+
+func (transfer OutgoingTransfer) String() string {
+	return fmt.Sprintf("%d: %s (%s) from %s to %s at height %d", transfer.Nonce, transfer.Amount,
+		transfer.EthereumAmount, transfer.Sender, transfer.EthereumReceiver, transfer.Height)
+}
+
+// OutgoingTransfers is a list of OutgoingTransfer
+type OutgoingTransfers []OutgoingTransfer
+
+func (transfers OutgoingTransfers) String() string {
+	out := make([]string, len(transfers))
+	for i, transfer := range transfers {
+		out[i] = transfer.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// ValsetMember is a bonded validator with a registered ethereum key, and its power
+type ValsetMember struct {
+	Validator       sdk.ValAddress `json:"validator"`
+	EthereumAddress string         `json:"ethereum_address"`
+	Power           int64          `json:"power"`
+}
+
+// NewValsetMember returns a new ValsetMember
+func NewValsetMember(validator sdk.ValAddress, ethereumAddress string, power int64) ValsetMember {
+	return ValsetMember{
+		Validator:       validator,
+		EthereumAddress: ethereumAddress,
+		Power:           power,
+	}
+}
+
+// Valset is a checkpoint of the validators that can attest on ethereum, ordered by decreasing power
+type Valset struct {
+	Nonce   uint64         `json:"nonce"`
+	Members []ValsetMember `json:"members"`
+	Height  int64          `json:"height"`
+}
+
+// NewValset returns a new Valset
+func NewValset(nonce uint64, members []ValsetMember, height int64) Valset {
+	return Valset{
+		Nonce:   nonce,
+		Members: members,
+		Height:  height,
+	}
+}
+
+// SameMembers returns whether two valsets have the same members with the same powers
+func (valset Valset) SameMembers(other Valset) bool {
+	if len(valset.Members) != len(other.Members) {
+		return false
+	}
+	for i, member := range valset.Members {
+		if !member.Validator.Equals(other.Members[i].Validator) ||
+			member.EthereumAddress != other.Members[i].EthereumAddress || member.Power != other.Members[i].Power {
+			return false
+		}
+	}
+	return true
+}
+
+// Member returns the member of the valset that is a validator
+func (valset Valset) Member(validator sdk.ValAddress) (ValsetMember, bool) {
+	for _, member := range valset.Members {
+		if member.Validator.Equals(validator) {
+			return member, true
+		}
+	}
+	return ValsetMember{}, false
+}
+
+// TotalPower returns the sum of the powers of the members
+func (valset Valset) TotalPower() int64 {
+	var total int64
+	for _, member := range valset.Members {
+		total += member.Power
+	}
+	return total
+}
+
+// Checkpoint returns the hash validators sign for the valset, which an ethereum contract recomputes as
+// keccak256(abi.encode(bytes32("valset"), nonce, addresses, powers))
+func (valset Valset) Checkpoint() []byte {
+	addresses := make([]gethCommon.Address, len(valset.Members))
+	powers := make([]*big.Int, len(valset.Members))
+	for i, member := range valset.Members {
+		addresses[i] = gethCommon.HexToAddress(member.EthereumAddress)
+		powers[i] = big.NewInt(member.Power)
+	}
+	bz, err := valsetCheckpointArgs.Pack(checkpointMethod(AttestationKindValset), new(big.Int).SetUint64(valset.Nonce),
+		addresses, powers)
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256(bz)
+}
+
+func (valset Valset) String() string {
+	members := make([]string, len(valset.Members))
+	for i, member := range valset.Members {
+		members[i] = fmt.Sprintf("  %s %s: %d", member.Validator, member.EthereumAddress, member.Power)
+	}
+	return fmt.Sprintf("%d at height %d:\n%s", valset.Nonce, valset.Height, strings.Join(members, "\n"))
+}
+
+// Valsets is a list of Valset
+type Valsets []Valset
+
+func (valsets Valsets) String() string {
+	out := make([]string, len(valsets))
+	for i, valset := range valsets {
+		out[i] = valset.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// AttestationSignature is a validator's personal_sign signature over the checkpoint of an attestation, made with its
+// registered ethereum key
+type AttestationSignature struct {
+	Validator       sdk.ValAddress `json:"validator"`
+	EthereumAddress string         `json:"ethereum_address"`
+	Power           int64          `json:"power"`
+	Signature       []byte         `json:"signature"`
+}
+
+// NewAttestationSignature returns a new AttestationSignature
+func NewAttestationSignature(validator sdk.ValAddress, ethereumAddress string, power int64, signature []byte) AttestationSignature {
+	return AttestationSignature{
+		Validator:       validator,
+		EthereumAddress: ethereumAddress,
+		Power:           power,
+		Signature:       signature,
+	}
+}
+
+// Attestation collects the validator signatures over the checkpoint of an outgoing transfer or a valset. The
+// signatures are weighed by the powers of the members of ValsetNonce, the last valset attested when they were
+// made, which is the one an ethereum contract that trusts the validator set verifies them against. It is complete
+// once the signers hold the signature threshold of that valset's total power.
+type Attestation struct {
+	Kind        string                 `json:"kind"`
+	Nonce       uint64                 `json:"nonce"`
+	Checkpoint  string                 `json:"checkpoint"`
+	Signatures  []AttestationSignature `json:"signatures"`
+	ValsetNonce uint64                 `json:"valset_nonce"`
+	SignedPower int64                  `json:"signed_power"`
+	Complete    bool                   `json:"complete"`
+	Height      int64                  `json:"height"`
+}
+
+// NewAttestation returns an attestation without signatures
+func NewAttestation(kind string, nonce uint64, checkpoint []byte, height int64) Attestation {
+	return Attestation{
+		Kind:       kind,
+		Nonce:      nonce,
+		Checkpoint: gethCommon.BytesToHash(checkpoint).Hex(),
+		Signatures: []AttestationSignature{},
+		Height:     height,
+	}
+}
+
+// CheckpointBytes returns the checkpoint the validators sign
+func (attestation Attestation) CheckpointBytes() []byte {
+	return gethCommon.HexToHash(attestation.Checkpoint).Bytes()
+}
+
+// HasSigned returns whether a validator already signed the attestation
+func (attestation Attestation) HasSigned(validator sdk.ValAddress) bool {
+	for _, signature := range attestation.Signatures {
+		if signature.Validator.Equals(validator) {
+			return true
+		}
+	}
+	return false
+}
+
+func (attestation Attestation) String() string {
+	signatures := make([]string, len(attestation.Signatures))
+	for i, signature := range attestation.Signatures {
+		signatures[i] = fmt.Sprintf("  %s %s (%d): 0x%x", signature.Validator, signature.EthereumAddress,
+			signature.Power, signature.Signature)
+	}
+	return strings.TrimSpace(fmt.Sprintf(`%s %d
+Checkpoint:   %s
+Valset:       %d
+Signed Power: %d
+Complete:     %t
+Signatures:
+%s`, attestation.Kind, attestation.Nonce, attestation.Checkpoint, attestation.ValsetNonce, attestation.SignedPower,
+		attestation.Complete, strings.Join(signatures, "\n")))
+}
+
+// Attestations is a list of Attestation
+type Attestations []Attestation
+
+func (attestations Attestations) String() string {
+	out := make([]string, len(attestations))
+	for i, attestation := range attestations {
+		out[i] = attestation.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -7,4 +7,20 @@ import (
 // RegisterCodec registers concrete types on the Amino codec
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgMakeEthBridgeClaim{}, "oracle/MsgMakeEthBridgeClaim", nil)
+	cdc.RegisterConcrete(MsgMakeEthBridgeClaims{}, "ethbridge/MsgMakeEthBridgeClaims", nil)
+	cdc.RegisterConcrete(MsgMakeEthBridgeReleaseClaim{}, "ethbridge/MsgMakeEthBridgeReleaseClaim", nil)
+	cdc.RegisterConcrete(MsgBurn{}, "ethbridge/MsgBurn", nil)
+	cdc.RegisterConcrete(MsgSetMintingPaused{}, "ethbridge/MsgSetMintingPaused", nil)
+	cdc.RegisterConcrete(MsgSetMintLimits{}, "ethbridge/MsgSetMintLimits", nil)
+	cdc.RegisterConcrete(MsgReleaseQueuedMint{}, "ethbridge/MsgReleaseQueuedMint", nil)
+	cdc.RegisterConcrete(MsgSetReleaseDelay{}, "ethbridge/MsgSetReleaseDelay", nil)
+	cdc.RegisterConcrete(MsgCancelRelease{}, "ethbridge/MsgCancelRelease", nil)
+	cdc.RegisterConcrete(MsgSetConsensusTiers{}, "ethbridge/MsgSetConsensusTiers", nil)
+	cdc.RegisterConcrete(MsgSetBridgeFees{}, "ethbridge/MsgSetBridgeFees", nil)
+	cdc.RegisterConcrete(MsgSetFeeder{}, "ethbridge/MsgSetFeeder", nil)
+	cdc.RegisterConcrete(MsgRegisterEthereumKey{}, "ethbridge/MsgRegisterEthereumKey", nil)
+	cdc.RegisterConcrete(MsgSignAttestation{}, "ethbridge/MsgSignAttestation", nil)
+	cdc.RegisterConcrete(MsgMakeEthBridgeProvenClaim{}, "ethbridge/MsgMakeEthBridgeProvenClaim", nil)
+	cdc.RegisterConcrete(MsgSetTokenScales{}, "ethbridge/MsgSetTokenScales", nil)
+	cdc.RegisterConcrete(MsgSetTokenDenoms{}, "ethbridge/MsgSetTokenDenoms", nil)
 }
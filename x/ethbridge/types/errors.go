@@ -7,12 +7,52 @@ import (
 // Local code type
 type CodeType = sdk.CodeType
 
-//Exported code type numbers
+// Exported code type numbers
 const (
 	DefaultCodespace sdk.CodespaceType = "ethbridge"
 
 	CodeInvalidEthNonce   CodeType = 1
 	CodeInvalidEthAddress CodeType = 2
+	CodeInvalidItemID     CodeType = 3
+	CodeInvalidEthTxHash  CodeType = 4
+
+	CodeInsufficientBridgedSupply CodeType = 5
+	CodeAlreadyMinted             CodeType = 6
+
+	CodeUnauthorized       CodeType = 7
+	CodeInvalidMintLimit   CodeType = 8
+	CodeQueuedMintNotFound CodeType = 9
+	CodeMintingPaused      CodeType = 10
+
+	CodePendingReleaseNotFound CodeType = 11
+	CodeInvalidBridgeFee       CodeType = 12
+
+	CodeValidatorNotBonded CodeType = 13
+	CodeInvalidFeeder      CodeType = 14
+	CodeInvalidClaimBatch  CodeType = 15
+	CodeInvalidReleaseKind CodeType = 16
+
+	CodeInvalidEthereumKeySignature CodeType = 17
+	CodeEthereumKeyInUse            CodeType = 18
+
+	CodeAttestationNotFound           CodeType = 19
+	CodeEthereumKeyNotRegistered      CodeType = 20
+	CodeDuplicateAttestationSignature CodeType = 21
+	CodeInvalidAttestationKind        CodeType = 22
+
+	CodeInvalidLockProof   CodeType = 23
+	CodePeggyContractUnset CodeType = 24
+
+	CodeInvalidTokenScale CodeType = 25
+	CodeDustAmount        CodeType = 26
+
+	CodeInvalidPostMintAction CodeType = 27
+
+	CodeInvalidParams CodeType = 28
+
+	CodeInvalidTokenDenom CodeType = 29
+
+	CodeNotValsetMember CodeType = 30
 )
 
 func ErrInvalidEthNonce(codespace sdk.CodespaceType) sdk.Error {
@@ -22,3 +62,115 @@ func ErrInvalidEthNonce(codespace sdk.CodespaceType) sdk.Error {
 func ErrInvalidEthAddress(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidEthAddress, "invalid ethereum address provided, must be a valid hex-encoded Ethereum address")
 }
+
+func ErrInvalidItemID(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidItemID, "invalid peggy item id provided, must be a hex-encoded bytes32 value")
+}
+
+func ErrInvalidEthTxHash(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEthTxHash, "invalid ethereum transaction hash provided, must be a hex-encoded bytes32 value")
+}
+
+func ErrInsufficientBridgedSupply(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientBridgedSupply, "amount exceeds the outstanding bridged supply")
+}
+
+func ErrAlreadyMinted(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAlreadyMinted, "coins have already been minted for this peggy item")
+}
+
+func ErrUnauthorized(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorized, "signer is not authorized to administer the ethbridge")
+}
+
+func ErrInvalidMintLimit(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidMintLimit, "invalid mint limit: "+reason)
+}
+
+func ErrQueuedMintNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeQueuedMintNotFound, "no queued mint found for this peggy item")
+}
+
+func ErrMintingPaused(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMintingPaused, "minting of bridged coins is paused")
+}
+
+func ErrPendingReleaseNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodePendingReleaseNotFound, "no pending release found for this peggy item")
+}
+
+func ErrInvalidBridgeFee(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidBridgeFee, "invalid bridge fee: "+reason)
+}
+
+func ErrValidatorNotBonded(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorNotBonded, "claims can only be made for bonded validators")
+}
+
+func ErrInvalidFeeder(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidFeeder, "signer is not the registered feeder of the validator")
+}
+
+func ErrInvalidClaimBatch(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidClaimBatch, "invalid claim batch: "+reason)
+}
+
+func ErrInvalidReleaseKind(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidReleaseKind, "invalid release kind provided, must be withdraw or unlock")
+}
+
+func ErrInvalidEthereumKeySignature(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidEthereumKeySignature, "invalid ethereum key signature: "+reason)
+}
+
+func ErrEthereumKeyInUse(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEthereumKeyInUse, "ethereum address is already registered by another validator")
+}
+
+func ErrAttestationNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAttestationNotFound, "no attestation found for this kind and nonce")
+}
+
+func ErrEthereumKeyNotRegistered(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEthereumKeyNotRegistered, "validator has not registered an ethereum key")
+}
+
+func ErrDuplicateAttestationSignature(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeDuplicateAttestationSignature, "validator already signed this attestation")
+}
+
+func ErrInvalidAttestationKind(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAttestationKind, "invalid attestation kind provided, must be transfer or valset")
+}
+
+func ErrInvalidLockProof(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidLockProof, "invalid lock proof: "+reason)
+}
+
+func ErrPeggyContractUnset(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodePeggyContractUnset, "proven claims are disabled until the peggy contract param is set")
+}
+
+func ErrInvalidTokenScale(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTokenScale, "invalid token scale: "+reason)
+}
+
+func ErrDustAmount(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeDustAmount, "claimed amount cannot be minted: "+reason)
+}
+
+func ErrInvalidPostMintAction(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidPostMintAction, "invalid post-mint action: "+reason)
+}
+
+func ErrInvalidParams(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidParams, "invalid params: "+reason)
+}
+
+func ErrInvalidTokenDenom(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidTokenDenom, "invalid token denom: "+reason)
+}
+
+func ErrNotValsetMember(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNotValsetMember, "validator is not a member of the last attested valset")
+}
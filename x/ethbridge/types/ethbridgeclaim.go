@@ -3,55 +3,88 @@ package types
 import (
 	"encoding/json"
 	"fmt"
-	"strconv"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
 )
 
+// EthBridgeClaim is a validator's claim that a lock event happened on the Peggy contract. The ItemID is the
+// bytes32 id Peggy assigned to the locked item and the transaction hash and log index are the evidence of where
+// the event was emitted. The post-mint actions come from the memo of the lock's recipient.
 type EthBridgeClaim struct {
-	Nonce          int            `json:"nonce"`
-	EthereumSender string         `json:"ethereum_sender"`
-	CosmosReceiver sdk.AccAddress `json:"cosmos_receiver"`
-	Validator      sdk.AccAddress `json:"validator"`
-	Amount         sdk.Coins      `json:"amount"`
+	ItemID           string          `json:"item_id"`
+	EthereumTxHash   string          `json:"ethereum_tx_hash"`
+	EthereumLogIndex uint64          `json:"ethereum_log_index"`
+	Nonce            int             `json:"nonce"`
+	EthereumSender   string          `json:"ethereum_sender"`
+	CosmosReceiver   sdk.AccAddress  `json:"cosmos_receiver"`
+	Validator        sdk.AccAddress  `json:"validator"`
+	Amount           sdk.Coins       `json:"amount"`
+	PostMintActions  PostMintActions `json:"post_mint_actions,omitempty"`
 }
 
 // NewEthBridgeClaim is a constructor function for NewEthBridgeClaim
-func NewEthBridgeClaim(nonce int, ethereumSender string, cosmosReceiver sdk.AccAddress, validator sdk.AccAddress, amount sdk.Coins) EthBridgeClaim {
+func NewEthBridgeClaim(itemID string, ethereumTxHash string, ethereumLogIndex uint64, nonce int, ethereumSender string, cosmosReceiver sdk.AccAddress, validator sdk.AccAddress, amount sdk.Coins, postMintActions PostMintActions) EthBridgeClaim {
 	return EthBridgeClaim{
-		Nonce:          nonce,
-		EthereumSender: ethereumSender,
-		CosmosReceiver: cosmosReceiver,
-		Validator:      validator,
-		Amount:         amount,
+		ItemID:           itemID,
+		EthereumTxHash:   ethereumTxHash,
+		EthereumLogIndex: ethereumLogIndex,
+		Nonce:            nonce,
+		EthereumSender:   ethereumSender,
+		CosmosReceiver:   cosmosReceiver,
+		Validator:        validator,
+		Amount:           amount,
+		PostMintActions:  postMintActions,
 	}
 }
 
-//OracleClaim is the details of how the claim for each validator will be stored in the oracle
+// OracleClaim is the details of how the claim for each validator will be stored in the oracle.
+// All of the Ethereum evidence is part of the claim so that validators must agree on it for the prophecy to pass.
 type OracleClaim struct {
-	CosmosReceiver sdk.AccAddress `json:"cosmos_receiver"`
-	Amount         sdk.Coins      `json:"amount"`
+	EthereumTxHash   string          `json:"ethereum_tx_hash"`
+	EthereumLogIndex uint64          `json:"ethereum_log_index"`
+	Nonce            int             `json:"nonce"`
+	EthereumSender   string          `json:"ethereum_sender"`
+	CosmosReceiver   sdk.AccAddress  `json:"cosmos_receiver"`
+	Amount           sdk.Coins       `json:"amount"`
+	PostMintActions  PostMintActions `json:"post_mint_actions,omitempty"`
 }
 
 // NewOracleClaim is a constructor function for OracleClaim
-func NewOracleClaim(cosmosReceiver sdk.AccAddress, amount sdk.Coins) OracleClaim {
+func NewOracleClaim(ethereumTxHash string, ethereumLogIndex uint64, nonce int, ethereumSender string, cosmosReceiver sdk.AccAddress, amount sdk.Coins, postMintActions PostMintActions) OracleClaim {
 	return OracleClaim{
-		CosmosReceiver: cosmosReceiver,
-		Amount:         amount,
+		EthereumTxHash:   ethereumTxHash,
+		EthereumLogIndex: ethereumLogIndex,
+		Nonce:            nonce,
+		EthereumSender:   ethereumSender,
+		CosmosReceiver:   cosmosReceiver,
+		Amount:           amount,
+		PostMintActions:  postMintActions,
 	}
 }
 
+// CreateOracleClaimFromEthClaim converts an EthBridgeClaim into the prophecy id, validator and claim content used by the oracle.
+// Prophecies are keyed by the Peggy item id.
 func CreateOracleClaimFromEthClaim(cdc *codec.Codec, ethClaim EthBridgeClaim) (string, sdk.ValAddress, string) {
-	oracleId := strconv.Itoa(ethClaim.Nonce) + ethClaim.EthereumSender
-	claimContent := NewOracleClaim(ethClaim.CosmosReceiver, ethClaim.Amount)
+	oracleId := common.NormalizeEthHash(ethClaim.ItemID)
+	claimContent := NewOracleClaim(
+		common.NormalizeEthHash(ethClaim.EthereumTxHash),
+		ethClaim.EthereumLogIndex,
+		ethClaim.Nonce,
+		ethClaim.EthereumSender,
+		ethClaim.CosmosReceiver,
+		ethClaim.Amount,
+		ethClaim.PostMintActions,
+	)
 	claimBytes, _ := json.Marshal(claimContent)
 	claim := string(claimBytes)
 	validator := sdk.ValAddress(ethClaim.Validator)
 	return oracleId, validator, claim
 }
 
-func CreateEthClaimFromOracleString(nonce int, ethereumSender string, validator sdk.ValAddress, oracleClaimString string) (EthBridgeClaim, sdk.Error) {
+func CreateEthClaimFromOracleString(itemID string, validator sdk.ValAddress, oracleClaimString string) (EthBridgeClaim, sdk.Error) {
 	oracleClaim, err := CreateOracleClaimFromOracleString(oracleClaimString)
 	if err != nil {
 		return EthBridgeClaim{}, err
@@ -59,11 +92,15 @@ func CreateEthClaimFromOracleString(nonce int, ethereumSender string, validator
 
 	valAccAddress := sdk.AccAddress(validator)
 	return NewEthBridgeClaim(
-		nonce,
-		ethereumSender,
+		itemID,
+		oracleClaim.EthereumTxHash,
+		oracleClaim.EthereumLogIndex,
+		oracleClaim.Nonce,
+		oracleClaim.EthereumSender,
 		oracleClaim.CosmosReceiver,
 		valAccAddress,
 		oracleClaim.Amount,
+		oracleClaim.PostMintActions,
 	), nil
 }
 
@@ -78,3 +115,52 @@ func CreateOracleClaimFromOracleString(oracleClaimString string) (OracleClaim, s
 
 	return oracleClaim, nil
 }
+
+// ClaimRejectedStatus is the status of a claim in a batch that was rejected without reaching the oracle
+const ClaimRejectedStatus = "rejected"
+
+// ClaimResult is the outcome of one claim of a MsgMakeEthBridgeClaims: the prophecy status the claim led to,
+// or the error it was rejected with
+type ClaimResult struct {
+	ItemID    string            `json:"item_id"`
+	Status    string            `json:"status"`
+	Codespace sdk.CodespaceType `json:"codespace,omitempty"`
+	Code      sdk.CodeType      `json:"code,omitempty"`
+	Log       string            `json:"log,omitempty"`
+}
+
+// NewClaimResult returns the result of a claim that was processed by the oracle
+func NewClaimResult(itemID string, status string) ClaimResult {
+	return ClaimResult{
+		ItemID: itemID,
+		Status: status,
+	}
+}
+
+// NewRejectedClaimResult returns the result of a claim that was rejected
+func NewRejectedClaimResult(itemID string, err sdk.Error) ClaimResult {
+	return ClaimResult{
+		ItemID:    itemID,
+		Status:    ClaimRejectedStatus,
+		Codespace: err.Codespace(),
+		Code:      err.Code(),
+		Log:       err.ABCILog(),
+	}
+}
+
+// String implements fmt.Stringer
+func (result ClaimResult) String() string {
+	return fmt.Sprintf("%s: %s", result.ItemID, result.Status)
+}
+
+// ClaimResults is the list of results of a MsgMakeEthBridgeClaims, in the order of its claims
+type ClaimResults []ClaimResult
+
+// String implements fmt.Stringer
+func (results ClaimResults) String() string {
+	out := make([]string, len(results))
+	for i, result := range results {
+		out[i] = result.String()
+	}
+	return strings.Join(out, "\n")
+}
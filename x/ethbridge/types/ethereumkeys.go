@@ -0,0 +1,53 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EthereumKey is the ethereum address a validator proved it holds the key of, which identifies the validator in the
+// cosmos to ethereum flows of the bridge
+type EthereumKey struct {
+	Validator       sdk.ValAddress `json:"validator"`
+	EthereumAddress string         `json:"ethereum_address"`
+	Height          int64          `json:"height"`
+}
+
+// NewEthereumKey returns a new EthereumKey
+func NewEthereumKey(validator sdk.ValAddress, ethereumAddress string, height int64) EthereumKey {
+	return EthereumKey{
+		Validator:       validator,
+		EthereumAddress: ethereumAddress,
+		Height:          height,
+	}
+}
+
+// String implements fmt.Stringer
+func (k EthereumKey) String() string {
+	return fmt.Sprintf("%s: %s (registered at height %d)", k.Validator, k.EthereumAddress, k.Height)
+}
+
+// EthereumKeys is a list of EthereumKey
+type EthereumKeys []EthereumKey
+
+// String implements fmt.Stringer
+func (ks EthereumKeys) String() string {
+	out := make([]string, len(ks))
+	for i, k := range ks {
+		out[i] = k.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// EthereumKeyDomain starts the message a validator signs to register its ethereum key, so the signature can't be
+// taken for one over any other data
+const EthereumKeyDomain = "ethbridge ethereum key registration"
+
+// EthereumKeySignBytes returns the text a validator signs with its ethereum key to register it, as signed by
+// personal_sign. It names the chain, so the registration can't be replayed on another chain or network where the
+// same operator address exists.
+func EthereumKeySignBytes(chainID string, validator sdk.ValAddress) []byte {
+	return []byte(fmt.Sprintf("%s\nchain-id: %s\nvalidator: %s", EthereumKeyDomain, chainID, validator))
+}
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Feeder is the account a validator registered to sign bridge claims on its behalf
+type Feeder struct {
+	Validator sdk.ValAddress `json:"validator"`
+	Feeder    sdk.AccAddress `json:"feeder"`
+}
+
+// NewFeeder returns a new Feeder
+func NewFeeder(validator sdk.ValAddress, feeder sdk.AccAddress) Feeder {
+	return Feeder{
+		Validator: validator,
+		Feeder:    feeder,
+	}
+}
+
+// String implements fmt.Stringer
+func (f Feeder) String() string {
+	return fmt.Sprintf("%s: %s", f.Validator, f.Feeder)
+}
+
+// Feeders is a list of Feeder
+type Feeders []Feeder
+
+// String implements fmt.Stringer
+func (fs Feeders) String() string {
+	out := make([]string, len(fs))
+	for i, f := range fs {
+		out[i] = f.String()
+	}
+	return strings.Join(out, "\n")
+}
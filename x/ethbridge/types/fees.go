@@ -0,0 +1,124 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// FeePoolAddress is the address of the module account that bridge fees are routed to before they are distributed
+// to the validators whose claims minted them. Remainders that cannot be split evenly stay in this account.
+var FeePoolAddress = sdk.AccAddress(crypto.AddressHash([]byte(ModuleName + "/fees")))
+
+// BridgeFee is the fee deducted from the coins of a denomination minted for a successful claim:
+// a flat amount plus a rate of the minted amount, never more than the minted amount
+type BridgeFee struct {
+	Denom string  `json:"denom"`
+	Rate  sdk.Dec `json:"rate"`
+	Flat  sdk.Int `json:"flat"`
+}
+
+// NewBridgeFee returns a new BridgeFee
+func NewBridgeFee(denom string, rate sdk.Dec, flat sdk.Int) BridgeFee {
+	return BridgeFee{
+		Denom: denom,
+		Rate:  rate,
+		Flat:  flat,
+	}
+}
+
+// ValidateBasic checks that the fee has a denomination, a rate between 0 and 1 and a non-negative flat amount
+func (fee BridgeFee) ValidateBasic() sdk.Error {
+	if fee.Denom == "" {
+		return ErrInvalidBridgeFee(DefaultCodespace, "denom cannot be empty")
+	}
+	if fee.Rate.IsNegative() || fee.Rate.GT(sdk.OneDec()) {
+		return ErrInvalidBridgeFee(DefaultCodespace, fmt.Sprintf("rate for %s must be >= 0 and <= 1", fee.Denom))
+	}
+	if fee.Flat.IsNegative() {
+		return ErrInvalidBridgeFee(DefaultCodespace, fmt.Sprintf("flat fee for %s cannot be negative", fee.Denom))
+	}
+	return nil
+}
+
+// Compute returns the fee for a minted amount of the fee's denomination
+func (fee BridgeFee) Compute(amount sdk.Int) sdk.Int {
+	total := fee.Flat.Add(fee.Rate.MulInt(amount).TruncateInt())
+	if total.GT(amount) {
+		return amount
+	}
+	return total
+}
+
+func (fee BridgeFee) String() string {
+	return fmt.Sprintf("%s: rate %s, flat %s", fee.Denom, fee.Rate, fee.Flat)
+}
+
+// BridgeFees is a list of per-denomination bridge fees
+type BridgeFees []BridgeFee
+
+// ValidateBasic validates every fee and checks that no denomination has two fees
+func (fees BridgeFees) ValidateBasic() sdk.Error {
+	seen := make(map[string]bool)
+	for _, fee := range fees {
+		if err := fee.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[fee.Denom] {
+			return ErrInvalidBridgeFee(DefaultCodespace, fmt.Sprintf("duplicate fee for %s", fee.Denom))
+		}
+		seen[fee.Denom] = true
+	}
+	return nil
+}
+
+// Compute returns the fees for a minted amount
+func (fees BridgeFees) Compute(amount sdk.Coins) sdk.Coins {
+	total := sdk.Coins{}
+	for _, coin := range amount {
+		for _, fee := range fees {
+			if fee.Denom == coin.Denom {
+				total = total.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, fee.Compute(coin.Amount))))
+			}
+		}
+	}
+	return total
+}
+
+// FeeReward is the share of a claim's bridge fee paid to a validator whose claim matched the final claim
+type FeeReward struct {
+	ItemID    string         `json:"item_id"`
+	Validator sdk.ValAddress `json:"validator"`
+	Amount    sdk.Coins      `json:"amount"`
+	Height    int64          `json:"height"`
+}
+
+// NewFeeReward returns a new FeeReward
+func NewFeeReward(itemID string, validator sdk.ValAddress, amount sdk.Coins, height int64) FeeReward {
+	return FeeReward{
+		ItemID:    itemID,
+		Validator: validator,
+		Amount:    amount,
+		Height:    height,
+	}
+}
+
+func (reward FeeReward) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Item ID:   %s
+Validator: %s
+Amount:    %s
+Height:    %d`, reward.ItemID, reward.Validator, reward.Amount, reward.Height))
+}
+
+// FeeRewards is a list of fee rewards
+type FeeRewards []FeeReward
+
+func (rewards FeeRewards) String() string {
+	out := make([]string, len(rewards))
+	for i, reward := range rewards {
+		out[i] = reward.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+)
+
+// GenesisState is the state of the ethbridge module at genesis
+type GenesisState struct {
+	Params          Params          `json:"params"`
+	MintingPaused   bool            `json:"minting_paused"`
+	Supplies        Supplies        `json:"supplies"`
+	QueuedMints     QueuedMints     `json:"queued_mints"`
+	PendingReleases PendingReleases `json:"pending_releases"`
+	Feeders         Feeders         `json:"feeders"`
+	Withdrawals     Withdrawals     `json:"withdrawals"`
+	Refunds         Refunds         `json:"refunds"`
+	EthereumKeys    EthereumKeys    `json:"ethereum_keys"`
+
+	OutgoingTransfers OutgoingTransfers `json:"outgoing_transfers"`
+	Valsets           Valsets           `json:"valsets"`
+	Attestations      Attestations      `json:"attestations"`
+	Dusts             Dusts             `json:"dusts"`
+	PostMints         PostMints         `json:"post_mints"`
+}
+
+// NewGenesisState creates a new GenesisState
+func NewGenesisState(params Params, mintingPaused bool, supplies Supplies, queuedMints QueuedMints,
+	pendingReleases PendingReleases, feeders Feeders, withdrawals Withdrawals, refunds Refunds,
+	ethereumKeys EthereumKeys, outgoingTransfers OutgoingTransfers, valsets Valsets, attestations Attestations,
+	dusts Dusts, postMints PostMints) GenesisState {
+	return GenesisState{
+		Params:          params,
+		MintingPaused:   mintingPaused,
+		Supplies:        supplies,
+		QueuedMints:     queuedMints,
+		PendingReleases: pendingReleases,
+		Feeders:         feeders,
+		Withdrawals:     withdrawals,
+		Refunds:         refunds,
+		EthereumKeys:    ethereumKeys,
+
+		OutgoingTransfers: outgoingTransfers,
+		Valsets:           valsets,
+		Attestations:      attestations,
+		Dusts:             dusts,
+		PostMints:         postMints,
+	}
+}
+
+// DefaultGenesisState returns a genesis state with the default params and no bridged coins
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), false, Supplies{}, QueuedMints{}, PendingReleases{}, Feeders{}, Withdrawals{}, Refunds{},
+		EthereumKeys{}, OutgoingTransfers{}, Valsets{}, Attestations{}, Dusts{}, PostMints{})
+}
+
+// ValidateGenesis checks that the genesis state is consistent
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	for _, supply := range data.Supplies {
+		if !supply.Minted.Sub(supply.Burned).Equal(supply.Outstanding) {
+			return fmt.Errorf("inconsistent supply for %s", supply.Denom)
+		}
+	}
+	for _, mint := range data.QueuedMints {
+		if mint.Receiver.Empty() || !mint.Amount.IsValid() {
+			return fmt.Errorf("invalid queued mint for item %s", mint.ItemID)
+		}
+	}
+	for _, release := range data.PendingReleases {
+		if release.Receiver.Empty() || !release.Amount.IsValid() {
+			return fmt.Errorf("invalid pending release for item %s", release.ItemID)
+		}
+	}
+	for _, feeder := range data.Feeders {
+		if feeder.Validator.Empty() || feeder.Feeder.Empty() {
+			return fmt.Errorf("invalid feeder for validator %s", feeder.Validator)
+		}
+	}
+	for _, withdrawal := range data.Withdrawals {
+		if !IsValidReleaseKind(withdrawal.Kind) {
+			return fmt.Errorf("invalid withdrawal kind %s for item %s", withdrawal.Kind, withdrawal.ItemID)
+		}
+	}
+	for _, refund := range data.Refunds {
+		if refund.EthereumSender == "" || !refund.Amount.IsValid() {
+			return fmt.Errorf("invalid refund for item %s", refund.ItemID)
+		}
+	}
+	ethereumAddresses := make(map[string]bool)
+	for _, key := range data.EthereumKeys {
+		if key.Validator.Empty() || !common.IsValidEthAddress(key.EthereumAddress) {
+			return fmt.Errorf("invalid ethereum key for validator %s", key.Validator)
+		}
+		address := common.NormalizeEthAddress(key.EthereumAddress)
+		if ethereumAddresses[address] {
+			return fmt.Errorf("ethereum address %s is registered by more than one validator", address)
+		}
+		ethereumAddresses[address] = true
+	}
+	for _, attestation := range data.Attestations {
+		if !IsValidAttestationKind(attestation.Kind) {
+			return fmt.Errorf("invalid attestation kind %s for nonce %d", attestation.Kind, attestation.Nonce)
+		}
+	}
+	for _, dust := range data.Dusts {
+		if !dust.Amount.IsValid() {
+			return fmt.Errorf("invalid dust for item %s", dust.ItemID)
+		}
+	}
+	for _, postMint := range data.PostMints {
+		if err := postMint.Actions.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid post-mint actions for item %s: %s", postMint.ItemID, err)
+		}
+	}
+	return nil
+}
@@ -1,15 +1,211 @@
-package types
-
-const (
-	// ModuleName is the name of the ethereum bridge module
-	ModuleName = "ethbridge"
-
-	// StoreKey is the string store representation
-	StoreKey = ModuleName
-
-	// QuerierRoute is the querier route for the ethereum bridge module
-	QuerierRoute = ModuleName
-
-	// RouterKey is the msg router key for the ethereum bridge module
-	RouterKey = ModuleName
-)
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+)
+
+const (
+	// ModuleName is the name of the ethereum bridge module
+	ModuleName = "ethbridge"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the ethereum bridge module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the ethereum bridge module
+	RouterKey = ModuleName
+)
+
+var (
+	// TxHashPrefix is the prefix for the index from an ethereum transaction hash and log index to a prophecy id
+	TxHashPrefix = []byte{0x00}
+
+	// SupplyPrefix is the prefix for the bridged supply of each denomination
+	SupplyPrefix = []byte{0x01}
+
+	// MintedClaimPrefix is the prefix for the amount minted for each successful claim
+	MintedClaimPrefix = []byte{0x02}
+
+	// MintingPausedKey is the key of the circuit breaker flag that holds back all minting
+	MintingPausedKey = []byte{0x03}
+
+	// QueuedMintPrefix is the prefix for successful claims queued by the circuit breaker or the mint limits
+	QueuedMintPrefix = []byte{0x04}
+
+	// MintRecordPrefix is the prefix for the mints counted towards the mint limits, keyed by height
+	MintRecordPrefix = []byte{0x05}
+
+	// PendingReleasePrefix is the prefix for large transfers waiting out the release delay, keyed by peggy item id
+	PendingReleasePrefix = []byte{0x06}
+
+	// PendingReleaseHeightPrefix is the prefix for the index of pending releases by the height they are due
+	PendingReleaseHeightPrefix = []byte{0x07}
+
+	// FeeRewardPrefix is the prefix for the bridge fee rewards paid to validators, keyed by validator and peggy item id
+	FeeRewardPrefix = []byte{0x08}
+
+	// FeederPrefix is the prefix for the feeder account each validator registered to sign its claims
+	FeederPrefix = []byte{0x09}
+
+	// WithdrawalPrefix is the prefix for the peggy items whose locked funds were released on ethereum
+	WithdrawalPrefix = []byte{0x0A}
+
+	// RefundPrefix is the prefix for the peggy items whose lock prophecy failed and that await an unlock
+	RefundPrefix = []byte{0x0B}
+
+	// EthereumKeyPrefix is the prefix for the ethereum address each validator registered
+	EthereumKeyPrefix = []byte{0x0C}
+
+	// EthereumAddressPrefix is the prefix for the index of registered ethereum addresses to their validator
+	EthereumAddressPrefix = []byte{0x0D}
+
+	// OutgoingTransferPrefix is the prefix for the transfers of burned coins to ethereum, by nonce
+	OutgoingTransferPrefix = []byte{0x0E}
+
+	// ValsetPrefix is the prefix for the checkpoints of the validators that attest on ethereum, by nonce
+	ValsetPrefix = []byte{0x0F}
+
+	// AttestationPrefix is the prefix for the validator signatures collected for each transfer and valset
+	AttestationPrefix = []byte{0x10}
+
+	// LastNoncePrefix is the prefix for the last nonce given to the transfers and to the valsets
+	LastNoncePrefix = []byte{0x11}
+
+	// DustPrefix is the prefix for the remainders of successful claims that were too small to be minted
+	DustPrefix = []byte{0x12}
+
+	// PostMintPrefix is the prefix for the post-mint actions of successful claims
+	PostMintPrefix = []byte{0x13}
+
+	// CreditPrefix is the prefix for the coins the mint of each peggy item left with its receiver
+	CreditPrefix = []byte{0x14}
+)
+
+// GetTxHashPrefixKey returns the prefix under which all prophecy ids claimed for an ethereum transaction are indexed
+func GetTxHashPrefixKey(ethereumTxHash string) []byte {
+	return append(TxHashPrefix, []byte(ethereumTxHash+"/")...)
+}
+
+// GetTxHashKey returns the index key for a prophecy claimed for a single log of an ethereum transaction
+func GetTxHashKey(ethereumTxHash string, ethereumLogIndex uint64, id string) []byte {
+	key := append(GetTxHashPrefixKey(ethereumTxHash), sdk.Uint64ToBigEndian(ethereumLogIndex)...)
+	return append(key, []byte(id)...)
+}
+
+// GetSupplyKey returns the key for the bridged supply of a denomination
+func GetSupplyKey(denom string) []byte {
+	return append(SupplyPrefix, []byte(denom)...)
+}
+
+// GetMintedClaimKey returns the key for the amount minted for a peggy item
+func GetMintedClaimKey(itemID string) []byte {
+	return append(MintedClaimPrefix, []byte(itemID)...)
+}
+
+// GetQueuedMintKey returns the key for the queued mint of a peggy item
+func GetQueuedMintKey(itemID string) []byte {
+	return append(QueuedMintPrefix, []byte(itemID)...)
+}
+
+// GetMintRecordHeightKey returns the prefix under which all mints at a height are recorded
+func GetMintRecordHeightKey(height int64) []byte {
+	return append(MintRecordPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetMintRecordKey returns the key for the record of a mint at a height
+func GetMintRecordKey(height int64, itemID string) []byte {
+	return append(GetMintRecordHeightKey(height), []byte(itemID)...)
+}
+
+// GetPendingReleaseKey returns the key for the pending release of a peggy item
+func GetPendingReleaseKey(itemID string) []byte {
+	return append(PendingReleasePrefix, []byte(itemID)...)
+}
+
+// GetPendingReleaseHeightPrefixKey returns the prefix under which the pending releases due at a height are indexed
+func GetPendingReleaseHeightPrefixKey(height int64) []byte {
+	return append(PendingReleaseHeightPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetPendingReleaseHeightKey returns the index key for a pending release due at a height
+func GetPendingReleaseHeightKey(height int64, itemID string) []byte {
+	return append(GetPendingReleaseHeightPrefixKey(height), []byte(itemID)...)
+}
+
+// GetValidatorFeeRewardsPrefixKey returns the prefix under which the fee rewards of a validator are stored
+func GetValidatorFeeRewardsPrefixKey(validator sdk.ValAddress) []byte {
+	return append(FeeRewardPrefix, validator.Bytes()...)
+}
+
+// GetFeeRewardKey returns the key for the fee reward paid to a validator for a peggy item
+func GetFeeRewardKey(validator sdk.ValAddress, itemID string) []byte {
+	return append(GetValidatorFeeRewardsPrefixKey(validator), []byte(itemID)...)
+}
+
+// GetFeederKey returns the key under which a validator's feeder is stored
+func GetFeederKey(validator sdk.ValAddress) []byte {
+	return append(FeederPrefix, validator.Bytes()...)
+}
+
+// GetWithdrawalKey returns the key under which the withdrawal of a peggy item is stored
+func GetWithdrawalKey(itemID string) []byte {
+	return append(WithdrawalPrefix, []byte(itemID)...)
+}
+
+// GetRefundKey returns the key under which the refund of a peggy item is stored
+func GetRefundKey(itemID string) []byte {
+	return append(RefundPrefix, []byte(itemID)...)
+}
+
+// GetEthereumKeyKey returns the key under which the ethereum key of a validator is stored
+func GetEthereumKeyKey(validator sdk.ValAddress) []byte {
+	return append(EthereumKeyPrefix, validator.Bytes()...)
+}
+
+// GetEthereumAddressKey returns the key under which the validator that registered an ethereum address is indexed
+func GetEthereumAddressKey(ethereumAddress string) []byte {
+	return append(EthereumAddressPrefix, []byte(common.NormalizeEthAddress(ethereumAddress))...)
+}
+
+// GetOutgoingTransferKey returns the key under which the outgoing transfer with a nonce is stored
+func GetOutgoingTransferKey(nonce uint64) []byte {
+	return append(OutgoingTransferPrefix, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// GetValsetKey returns the key under which the valset with a nonce is stored
+func GetValsetKey(nonce uint64) []byte {
+	return append(ValsetPrefix, sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// GetAttestationKey returns the key under which the attestation of a transfer or valset is stored
+func GetAttestationKey(kind string, nonce uint64) []byte {
+	return append(GetAttestationPrefixKey(kind), sdk.Uint64ToBigEndian(nonce)...)
+}
+
+// GetAttestationPrefixKey returns the prefix under which the attestations of a kind are stored
+func GetAttestationPrefixKey(kind string) []byte {
+	return append(AttestationPrefix, []byte(kind+"/")...)
+}
+
+// GetDustKey returns the key for the dust of a peggy item
+func GetDustKey(itemID string) []byte {
+	return append(DustPrefix, []byte(itemID)...)
+}
+
+// GetLastNonceKey returns the key under which the last nonce of a kind of attestation is stored
+func GetLastNonceKey(kind string) []byte {
+	return append(LastNoncePrefix, []byte(kind)...)
+}
+
+// GetPostMintKey returns the key for the post-mint actions of a peggy item
+func GetPostMintKey(itemID string) []byte {
+	return append(PostMintPrefix, []byte(itemID)...)
+}
+
+// GetCreditKey returns the key for the coins the mint of a peggy item left with its receiver
+func GetCreditKey(itemID string) []byte {
+	return append(CreditPrefix, []byte(itemID)...)
+}
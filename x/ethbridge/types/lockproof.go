@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// LockEventSignature is the signature of the peggy LogLock event
+const LockEventSignature = "LogLock(bytes32,address,bytes,address,uint256,uint256)"
+
+var (
+	bytesType, _ = abi.NewType("bytes", "", nil)
+
+	// LockEventTopic is the first topic of every LogLock log
+	LockEventTopic = crypto.Keccak256Hash([]byte(LockEventSignature))
+
+	// lockEventArgs are the unindexed arguments of LogLock, all of which are in the log data
+	lockEventArgs = abi.Arguments{{Type: bytes32Type}, {Type: addressType}, {Type: bytesType}, {Type: addressType},
+		{Type: uint256Type}, {Type: uint256Type}}
+)
+
+// LockLog is a decoded peggy LogLock log
+type LockLog struct {
+	ID    gethCommon.Hash
+	From  gethCommon.Address
+	To    []byte
+	Token gethCommon.Address
+	Value *big.Int
+	Nonce *big.Int
+}
+
+// DecodeLockLog decodes a LogLock log emitted by the peggy contract
+func DecodeLockLog(log *ethtypes.Log, peggyContract string) (LockLog, error) {
+	if log.Address != gethCommon.HexToAddress(peggyContract) {
+		return LockLog{}, fmt.Errorf("log was emitted by %s, not the peggy contract", log.Address.Hex())
+	}
+	if len(log.Topics) == 0 || log.Topics[0] != LockEventTopic {
+		return LockLog{}, fmt.Errorf("log is not a LogLock log")
+	}
+	values, err := lockEventArgs.Unpack(log.Data)
+	if err != nil {
+		return LockLog{}, err
+	}
+	return LockLog{
+		ID:    gethCommon.Hash(values[0].([32]byte)),
+		From:  values[1].(gethCommon.Address),
+		To:    values[2].([]byte),
+		Token: values[3].(gethCommon.Address),
+		Value: values[4].(*big.Int),
+		Nonce: values[5].(*big.Int),
+	}, nil
+}
+
+// EncodeLockLogData encodes the data of a LogLock log
+func EncodeLockLogData(lockLog LockLog) ([]byte, error) {
+	return lockEventArgs.Pack([32]byte(lockLog.ID), lockLog.From, lockLog.To, lockLog.Token, lockLog.Value,
+		lockLog.Nonce)
+}
+
+// Matches checks that the claim describes the locked item of the log: the same item id, sender, receiver, nonce
+// and a single coin of the locked value in the denomination of the locked token
+func (lockLog LockLog) Matches(claim EthBridgeClaim, tokenDenoms TokenDenoms) error {
+	if gethCommon.HexToHash(claim.ItemID) != lockLog.ID {
+		return fmt.Errorf("item id %s does not match the logged %s", claim.ItemID, lockLog.ID.Hex())
+	}
+	if gethCommon.HexToAddress(claim.EthereumSender) != lockLog.From {
+		return fmt.Errorf("sender %s does not match the logged %s", claim.EthereumSender, lockLog.From.Hex())
+	}
+	receiver, actions, err := ParseRecipient(lockLog.To)
+	if err != nil {
+		return fmt.Errorf("invalid logged recipient %s: %s", string(lockLog.To), err)
+	}
+	if !claim.CosmosReceiver.Equals(receiver) || !claim.PostMintActions.Equal(actions) {
+		return fmt.Errorf("receiver %s does not match the logged %s", claim.CosmosReceiver, string(lockLog.To))
+	}
+	if big.NewInt(int64(claim.Nonce)).Cmp(lockLog.Nonce) != 0 {
+		return fmt.Errorf("nonce %d does not match the logged %s", claim.Nonce, lockLog.Nonce)
+	}
+	if len(claim.Amount) != 1 || claim.Amount[0].Amount.BigInt().Cmp(lockLog.Value) != 0 {
+		return fmt.Errorf("amount %s does not match the logged %s", claim.Amount, lockLog.Value)
+	}
+	denom, ok := tokenDenoms.DenomOf(lockLog.Token)
+	if !ok {
+		return fmt.Errorf("logged token %s is not bridged", lockLog.Token.Hex())
+	}
+	if claim.Amount[0].Denom != denom {
+		return fmt.Errorf("denom %s does not match the logged token %s", claim.Amount[0].Denom, lockLog.Token.Hex())
+	}
+	return nil
+}
@@ -2,19 +2,31 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+	ethheaders "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
 )
 
-// MsgMakeEthBridgeClaim defines a message for creating claims on the ethereum bridge
+// MsgMakeEthBridgeClaim defines a message for creating claims on the ethereum bridge. A claim is signed by its
+// validator, or by the feeder account the validator registered when Feeder is set.
 type MsgMakeEthBridgeClaim struct {
 	EthBridgeClaim `json:"eth_bridge_claim"`
+	Feeder         sdk.AccAddress `json:"feeder,omitempty"`
 }
 
 // NewMsgMakeEthBridgeClaim is a constructor function for MsgMakeBridgeClaim
 func NewMsgMakeEthBridgeClaim(ethBridgeClaim EthBridgeClaim) MsgMakeEthBridgeClaim {
-	return MsgMakeEthBridgeClaim{ethBridgeClaim}
+	return MsgMakeEthBridgeClaim{EthBridgeClaim: ethBridgeClaim}
+}
+
+// NewMsgMakeEthBridgeClaimFromFeeder is a constructor function for a MsgMakeBridgeClaim signed by a validator's feeder
+func NewMsgMakeEthBridgeClaimFromFeeder(ethBridgeClaim EthBridgeClaim, feeder sdk.AccAddress) MsgMakeEthBridgeClaim {
+	return MsgMakeEthBridgeClaim{
+		EthBridgeClaim: ethBridgeClaim,
+		Feeder:         feeder,
+	}
 }
 
 // Route should return the name of the module
@@ -28,13 +40,25 @@ func (msg MsgMakeEthBridgeClaim) ValidateBasic() sdk.Error {
 	if msg.EthBridgeClaim.CosmosReceiver.Empty() {
 		return sdk.ErrInvalidAddress(msg.CosmosReceiver.String())
 	}
+	if msg.EthBridgeClaim.Validator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Validator.String())
+	}
 	if msg.EthBridgeClaim.Nonce < 0 {
 		return ErrInvalidEthNonce(DefaultCodespace)
 	}
 	if !common.IsValidEthAddress(msg.EthBridgeClaim.EthereumSender) {
 		return ErrInvalidEthAddress(DefaultCodespace)
 	}
-	return nil
+	if !common.IsValidEthHash(msg.EthBridgeClaim.ItemID) {
+		return ErrInvalidItemID(DefaultCodespace)
+	}
+	if !common.IsValidEthHash(msg.EthBridgeClaim.EthereumTxHash) {
+		return ErrInvalidEthTxHash(DefaultCodespace)
+	}
+	if !msg.EthBridgeClaim.Amount.IsValid() || !msg.EthBridgeClaim.Amount.IsAllPositive() {
+		return sdk.ErrInvalidCoins(msg.EthBridgeClaim.Amount.String())
+	}
+	return msg.EthBridgeClaim.PostMintActions.ValidateBasic()
 }
 
 // GetSignBytes encodes the message for signing
@@ -48,5 +72,820 @@ func (msg MsgMakeEthBridgeClaim) GetSignBytes() []byte {
 
 // GetSigners defines whose signature is required
 func (msg MsgMakeEthBridgeClaim) GetSigners() []sdk.AccAddress {
+	if !msg.Feeder.Empty() {
+		return []sdk.AccAddress{msg.Feeder}
+	}
 	return []sdk.AccAddress{msg.EthBridgeClaim.Validator}
 }
+
+// MaxClaimsPerBatch is the maximum number of claims a MsgMakeEthBridgeClaims can carry
+const MaxClaimsPerBatch = 100
+
+// MsgMakeEthBridgeClaims defines a message for making several claims of one validator in a single transaction,
+// such as every lock event of an ethereum block. Each claim is processed on its own, so one failed claim does not
+// abort the others.
+type MsgMakeEthBridgeClaims struct {
+	EthBridgeClaims []EthBridgeClaim `json:"eth_bridge_claims"`
+	Feeder          sdk.AccAddress   `json:"feeder,omitempty"`
+}
+
+// NewMsgMakeEthBridgeClaims is a constructor function for MsgMakeEthBridgeClaims
+func NewMsgMakeEthBridgeClaims(ethBridgeClaims []EthBridgeClaim, feeder sdk.AccAddress) MsgMakeEthBridgeClaims {
+	return MsgMakeEthBridgeClaims{
+		EthBridgeClaims: ethBridgeClaims,
+		Feeder:          feeder,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgMakeEthBridgeClaims) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMakeEthBridgeClaims) Type() string { return "make_bridge_claims" }
+
+// ValidateBasic runs stateless checks on every claim and checks that they are all made for the same validator
+func (msg MsgMakeEthBridgeClaims) ValidateBasic() sdk.Error {
+	if len(msg.EthBridgeClaims) == 0 {
+		return ErrInvalidClaimBatch(DefaultCodespace, "no claims")
+	}
+	if len(msg.EthBridgeClaims) > MaxClaimsPerBatch {
+		return ErrInvalidClaimBatch(DefaultCodespace, fmt.Sprintf("more than %d claims", MaxClaimsPerBatch))
+	}
+	validator := msg.Validator()
+	for _, claim := range msg.EthBridgeClaims {
+		if !claim.Validator.Equals(validator) {
+			return ErrInvalidClaimBatch(DefaultCodespace, "claims are made for different validators")
+		}
+		if err := NewMsgMakeEthBridgeClaim(claim).ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMakeEthBridgeClaims) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMakeEthBridgeClaims) GetSigners() []sdk.AccAddress {
+	if !msg.Feeder.Empty() {
+		return []sdk.AccAddress{msg.Feeder}
+	}
+	return []sdk.AccAddress{msg.Validator()}
+}
+
+// Validator returns the validator the claims are made for
+func (msg MsgMakeEthBridgeClaims) Validator() sdk.AccAddress {
+	if len(msg.EthBridgeClaims) == 0 {
+		return nil
+	}
+	return msg.EthBridgeClaims[0].Validator
+}
+
+// MsgMakeEthBridgeReleaseClaim defines a message for claiming that the locked funds of a peggy item were withdrawn
+// or unlocked on ethereum
+type MsgMakeEthBridgeReleaseClaim struct {
+	EthBridgeReleaseClaim `json:"eth_bridge_release_claim"`
+	Feeder                sdk.AccAddress `json:"feeder,omitempty"`
+}
+
+// NewMsgMakeEthBridgeReleaseClaim is a constructor function for MsgMakeEthBridgeReleaseClaim
+func NewMsgMakeEthBridgeReleaseClaim(releaseClaim EthBridgeReleaseClaim, feeder sdk.AccAddress) MsgMakeEthBridgeReleaseClaim {
+	return MsgMakeEthBridgeReleaseClaim{
+		EthBridgeReleaseClaim: releaseClaim,
+		Feeder:                feeder,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgMakeEthBridgeReleaseClaim) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMakeEthBridgeReleaseClaim) Type() string { return "make_bridge_release_claim" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgMakeEthBridgeReleaseClaim) ValidateBasic() sdk.Error {
+	if msg.Validator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Validator.String())
+	}
+	if !common.IsValidEthHash(msg.ItemID) {
+		return ErrInvalidItemID(DefaultCodespace)
+	}
+	if !common.IsValidEthHash(msg.EthereumTxHash) {
+		return ErrInvalidEthTxHash(DefaultCodespace)
+	}
+	if !common.IsValidEthAddress(msg.EthereumReceiver) {
+		return ErrInvalidEthAddress(DefaultCodespace)
+	}
+	if !IsValidReleaseKind(msg.Kind) {
+		return ErrInvalidReleaseKind(DefaultCodespace)
+	}
+	if !msg.Amount.IsValid() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMakeEthBridgeReleaseClaim) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMakeEthBridgeReleaseClaim) GetSigners() []sdk.AccAddress {
+	if !msg.Feeder.Empty() {
+		return []sdk.AccAddress{msg.Feeder}
+	}
+	return []sdk.AccAddress{msg.Validator}
+}
+
+// MsgBurn defines a message for burning bridged coins so they can be released to an ethereum address
+type MsgBurn struct {
+	Sender           sdk.AccAddress `json:"sender"`
+	EthereumReceiver string         `json:"ethereum_receiver"`
+	Amount           sdk.Coins      `json:"amount"`
+}
+
+// NewMsgBurn is a constructor function for MsgBurn
+func NewMsgBurn(sender sdk.AccAddress, ethereumReceiver string, amount sdk.Coins) MsgBurn {
+	return MsgBurn{
+		Sender:           sender,
+		EthereumReceiver: ethereumReceiver,
+		Amount:           amount,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgBurn) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgBurn) Type() string { return "burn" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgBurn) ValidateBasic() sdk.Error {
+	if msg.Sender.Empty() {
+		return sdk.ErrInvalidAddress(msg.Sender.String())
+	}
+	if !common.IsValidEthAddress(msg.EthereumReceiver) {
+		return ErrInvalidEthAddress(DefaultCodespace)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return sdk.ErrInvalidCoins(msg.Amount.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgBurn) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+// MsgSetMintingPaused defines a message for the admin to trip or reset the bridge-wide circuit breaker.
+// While minting is paused, successful claims are queued instead of minted.
+type MsgSetMintingPaused struct {
+	Admin  sdk.AccAddress `json:"admin"`
+	Paused bool           `json:"paused"`
+}
+
+// NewMsgSetMintingPaused is a constructor function for MsgSetMintingPaused
+func NewMsgSetMintingPaused(admin sdk.AccAddress, paused bool) MsgSetMintingPaused {
+	return MsgSetMintingPaused{
+		Admin:  admin,
+		Paused: paused,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetMintingPaused) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetMintingPaused) Type() string { return "set_minting_paused" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetMintingPaused) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress(msg.Admin.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetMintingPaused) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetMintingPaused) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgSetMintLimits defines a message for the admin to change the mint window and the mint limits
+type MsgSetMintLimits struct {
+	Admin      sdk.AccAddress `json:"admin"`
+	MintWindow int64          `json:"mint_window"`
+	MintLimits MintLimits     `json:"mint_limits"`
+}
+
+// NewMsgSetMintLimits is a constructor function for MsgSetMintLimits
+func NewMsgSetMintLimits(admin sdk.AccAddress, mintWindow int64, mintLimits MintLimits) MsgSetMintLimits {
+	return MsgSetMintLimits{
+		Admin:      admin,
+		MintWindow: mintWindow,
+		MintLimits: mintLimits,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetMintLimits) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetMintLimits) Type() string { return "set_mint_limits" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetMintLimits) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress(msg.Admin.String())
+	}
+	if msg.MintWindow <= 0 {
+		return ErrInvalidMintLimit(DefaultCodespace, "mint window must be positive")
+	}
+	return msg.MintLimits.ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetMintLimits) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetMintLimits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgReleaseQueuedMint defines a message for the admin to mint a queued successful claim
+type MsgReleaseQueuedMint struct {
+	Admin  sdk.AccAddress `json:"admin"`
+	ItemID string         `json:"item_id"`
+}
+
+// NewMsgReleaseQueuedMint is a constructor function for MsgReleaseQueuedMint
+func NewMsgReleaseQueuedMint(admin sdk.AccAddress, itemID string) MsgReleaseQueuedMint {
+	return MsgReleaseQueuedMint{
+		Admin:  admin,
+		ItemID: itemID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgReleaseQueuedMint) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgReleaseQueuedMint) Type() string { return "release_queued_mint" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgReleaseQueuedMint) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress(msg.Admin.String())
+	}
+	if !common.IsValidEthHash(msg.ItemID) {
+		return ErrInvalidItemID(DefaultCodespace)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgReleaseQueuedMint) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgReleaseQueuedMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgSetReleaseDelay defines a message for the admin to change the release delay and the delay thresholds
+type MsgSetReleaseDelay struct {
+	Admin           sdk.AccAddress `json:"admin"`
+	ReleaseDelay    int64          `json:"release_delay"`
+	DelayThresholds sdk.Coins      `json:"delay_thresholds"`
+}
+
+// NewMsgSetReleaseDelay is a constructor function for MsgSetReleaseDelay
+func NewMsgSetReleaseDelay(admin sdk.AccAddress, releaseDelay int64, delayThresholds sdk.Coins) MsgSetReleaseDelay {
+	return MsgSetReleaseDelay{
+		Admin:           admin,
+		ReleaseDelay:    releaseDelay,
+		DelayThresholds: delayThresholds,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetReleaseDelay) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetReleaseDelay) Type() string { return "set_release_delay" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetReleaseDelay) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress(msg.Admin.String())
+	}
+	if msg.ReleaseDelay < 0 {
+		return sdk.ErrUnknownRequest("release delay cannot be negative")
+	}
+	if !msg.DelayThresholds.IsValid() {
+		return sdk.ErrInvalidCoins(msg.DelayThresholds.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetReleaseDelay) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetReleaseDelay) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgCancelRelease defines a message for the admin or guardian to cancel a pending release
+type MsgCancelRelease struct {
+	Signer sdk.AccAddress `json:"signer"`
+	ItemID string         `json:"item_id"`
+}
+
+// NewMsgCancelRelease is a constructor function for MsgCancelRelease
+func NewMsgCancelRelease(signer sdk.AccAddress, itemID string) MsgCancelRelease {
+	return MsgCancelRelease{
+		Signer: signer,
+		ItemID: itemID,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgCancelRelease) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgCancelRelease) Type() string { return "cancel_release" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgCancelRelease) ValidateBasic() sdk.Error {
+	if msg.Signer.Empty() {
+		return sdk.ErrInvalidAddress(msg.Signer.String())
+	}
+	if !common.IsValidEthHash(msg.ItemID) {
+		return ErrInvalidItemID(DefaultCodespace)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCancelRelease) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCancelRelease) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Signer}
+}
+
+// MsgSetConsensusTiers defines a message for the admin to change the consensus tiers
+type MsgSetConsensusTiers struct {
+	Admin          sdk.AccAddress `json:"admin"`
+	ConsensusTiers ConsensusTiers `json:"consensus_tiers"`
+}
+
+// NewMsgSetConsensusTiers is a constructor function for MsgSetConsensusTiers
+func NewMsgSetConsensusTiers(admin sdk.AccAddress, consensusTiers ConsensusTiers) MsgSetConsensusTiers {
+	return MsgSetConsensusTiers{
+		Admin:          admin,
+		ConsensusTiers: consensusTiers,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetConsensusTiers) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetConsensusTiers) Type() string { return "set_consensus_tiers" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetConsensusTiers) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress(msg.Admin.String())
+	}
+	for _, tier := range msg.ConsensusTiers {
+		if !tier.Thresholds.IsValid() || tier.Thresholds.Empty() {
+			return sdk.ErrInvalidCoins(tier.Thresholds.String())
+		}
+		if tier.ConsensusNeeded.IsNil() || !tier.ConsensusNeeded.IsPositive() || tier.ConsensusNeeded.GT(sdk.OneDec()) {
+			return sdk.ErrUnknownRequest("consensus tier consensus must be > 0 and <= 1")
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetConsensusTiers) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetConsensusTiers) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgSetBridgeFees defines a message for the admin to change the bridge fees
+type MsgSetBridgeFees struct {
+	Admin      sdk.AccAddress `json:"admin"`
+	BridgeFees BridgeFees     `json:"bridge_fees"`
+}
+
+// NewMsgSetBridgeFees is a constructor function for MsgSetBridgeFees
+func NewMsgSetBridgeFees(admin sdk.AccAddress, bridgeFees BridgeFees) MsgSetBridgeFees {
+	return MsgSetBridgeFees{
+		Admin:      admin,
+		BridgeFees: bridgeFees,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetBridgeFees) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetBridgeFees) Type() string { return "set_bridge_fees" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetBridgeFees) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress(msg.Admin.String())
+	}
+	return msg.BridgeFees.ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetBridgeFees) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetBridgeFees) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgSetFeeder defines a message for a validator to register the account that signs bridge claims on its behalf,
+// so the validator's operator key can stay offline. An empty feeder removes the registration.
+type MsgSetFeeder struct {
+	Validator sdk.ValAddress `json:"validator"`
+	Feeder    sdk.AccAddress `json:"feeder"`
+}
+
+// NewMsgSetFeeder is a constructor function for MsgSetFeeder
+func NewMsgSetFeeder(validator sdk.ValAddress, feeder sdk.AccAddress) MsgSetFeeder {
+	return MsgSetFeeder{
+		Validator: validator,
+		Feeder:    feeder,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetFeeder) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetFeeder) Type() string { return "set_feeder" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetFeeder) ValidateBasic() sdk.Error {
+	if msg.Validator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Validator.String())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetFeeder) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetFeeder) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Validator)}
+}
+
+// MsgRegisterEthereumKey defines a message for a validator to register the ethereum address that identifies it in the
+// cosmos to ethereum flows, or to rotate it. Signature proves the validator holds the address's key: it is the
+// 65 byte personal_sign signature of EthereumKeySignBytes for the chain made with that key.
+type MsgRegisterEthereumKey struct {
+	Validator       sdk.ValAddress `json:"validator"`
+	EthereumAddress string         `json:"ethereum_address"`
+	Signature       []byte         `json:"signature"`
+}
+
+// NewMsgRegisterEthereumKey is a constructor function for MsgRegisterEthereumKey
+func NewMsgRegisterEthereumKey(validator sdk.ValAddress, ethereumAddress string, signature []byte) MsgRegisterEthereumKey {
+	return MsgRegisterEthereumKey{
+		Validator:       validator,
+		EthereumAddress: ethereumAddress,
+		Signature:       signature,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgRegisterEthereumKey) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgRegisterEthereumKey) Type() string { return "register_ethereum_key" }
+
+// ValidateBasic runs stateless checks on the message. The signature names the chain, so it is verified by the
+// handler with VerifySignature.
+func (msg MsgRegisterEthereumKey) ValidateBasic() sdk.Error {
+	if msg.Validator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Validator.String())
+	}
+	if !common.IsValidEthAddress(msg.EthereumAddress) {
+		return ErrInvalidEthAddress(DefaultCodespace)
+	}
+	if len(msg.Signature) == 0 {
+		return ErrInvalidEthereumKeySignature(DefaultCodespace, "signature cannot be empty")
+	}
+	return nil
+}
+
+// VerifySignature checks that the signature was made by the ethereum address for the validator on a chain
+func (msg MsgRegisterEthereumKey) VerifySignature(chainID string) sdk.Error {
+	signer, err := common.RecoverEthSigner(EthereumKeySignBytes(chainID, msg.Validator), msg.Signature)
+	if err != nil {
+		return ErrInvalidEthereumKeySignature(DefaultCodespace, err.Error())
+	}
+	if signer.Hex() != common.NormalizeEthAddress(msg.EthereumAddress) {
+		return ErrInvalidEthereumKeySignature(DefaultCodespace, fmt.Sprintf("signed by %s", signer.Hex()))
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRegisterEthereumKey) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRegisterEthereumKey) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.AccAddress(msg.Validator)}
+}
+
+// MsgSignAttestation defines a message for a validator to add its signature over the checkpoint of an outgoing
+// transfer or valset, made with its registered ethereum key. It is signed by the validator, or by the feeder account
+// the validator registered when Feeder is set.
+type MsgSignAttestation struct {
+	Kind      string         `json:"kind"`
+	Nonce     uint64         `json:"nonce"`
+	Validator sdk.ValAddress `json:"validator"`
+	Signature []byte         `json:"signature"`
+	Feeder    sdk.AccAddress `json:"feeder,omitempty"`
+}
+
+// NewMsgSignAttestation is a constructor function for MsgSignAttestation
+func NewMsgSignAttestation(kind string, nonce uint64, validator sdk.ValAddress, signature []byte, feeder sdk.AccAddress) MsgSignAttestation {
+	return MsgSignAttestation{
+		Kind:      kind,
+		Nonce:     nonce,
+		Validator: validator,
+		Signature: signature,
+		Feeder:    feeder,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSignAttestation) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSignAttestation) Type() string { return "sign_attestation" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSignAttestation) ValidateBasic() sdk.Error {
+	if msg.Validator.Empty() {
+		return sdk.ErrInvalidAddress(msg.Validator.String())
+	}
+	if !IsValidAttestationKind(msg.Kind) {
+		return ErrInvalidAttestationKind(DefaultCodespace)
+	}
+	if len(msg.Signature) == 0 {
+		return ErrInvalidEthereumKeySignature(DefaultCodespace, "signature cannot be empty")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSignAttestation) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSignAttestation) GetSigners() []sdk.AccAddress {
+	if !msg.Feeder.Empty() {
+		return []sdk.AccAddress{msg.Feeder}
+	}
+	return []sdk.AccAddress{sdk.AccAddress(msg.Validator)}
+}
+
+// MsgMakeEthBridgeProvenClaim defines a message for making a claim together with a Merkle-Patricia proof of the
+// receipt holding its LogLock log. The claim only counts once the receipt is proven against a confirmed header of
+// the ethheaders light client and the log at ReceiptLogIndex matches it.
+type MsgMakeEthBridgeProvenClaim struct {
+	EthBridgeClaim  `json:"eth_bridge_claim"`
+	ReceiptProof    ethheaders.ReceiptProof `json:"receipt_proof"`
+	ReceiptLogIndex uint64                  `json:"receipt_log_index"`
+	Feeder          sdk.AccAddress          `json:"feeder,omitempty"`
+}
+
+// NewMsgMakeEthBridgeProvenClaim is a constructor function for MsgMakeEthBridgeProvenClaim
+func NewMsgMakeEthBridgeProvenClaim(ethBridgeClaim EthBridgeClaim, receiptProof ethheaders.ReceiptProof,
+	receiptLogIndex uint64, feeder sdk.AccAddress) MsgMakeEthBridgeProvenClaim {
+	return MsgMakeEthBridgeProvenClaim{
+		EthBridgeClaim:  ethBridgeClaim,
+		ReceiptProof:    receiptProof,
+		ReceiptLogIndex: receiptLogIndex,
+		Feeder:          feeder,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgMakeEthBridgeProvenClaim) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgMakeEthBridgeProvenClaim) Type() string { return "make_bridge_proven_claim" }
+
+// ValidateBasic runs stateless checks on the claim and the proof
+func (msg MsgMakeEthBridgeProvenClaim) ValidateBasic() sdk.Error {
+	if err := NewMsgMakeEthBridgeClaim(msg.EthBridgeClaim).ValidateBasic(); err != nil {
+		return err
+	}
+	if !common.IsValidEthHash(msg.ReceiptProof.BlockHash) {
+		return ErrInvalidLockProof(DefaultCodespace, "invalid block hash")
+	}
+	if len(msg.ReceiptProof.Nodes) == 0 || len(msg.ReceiptProof.TransactionNodes) == 0 {
+		return ErrInvalidLockProof(DefaultCodespace, "proof has no nodes")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgMakeEthBridgeProvenClaim) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgMakeEthBridgeProvenClaim) GetSigners() []sdk.AccAddress {
+	if !msg.Feeder.Empty() {
+		return []sdk.AccAddress{msg.Feeder}
+	}
+	return []sdk.AccAddress{msg.EthBridgeClaim.Validator}
+}
+
+// MsgSetTokenScales defines a message for the admin to change the decimal scaling of bridged tokens
+type MsgSetTokenScales struct {
+	Admin       sdk.AccAddress `json:"admin"`
+	TokenScales TokenScales    `json:"token_scales"`
+}
+
+// NewMsgSetTokenScales is a constructor function for MsgSetTokenScales
+func NewMsgSetTokenScales(admin sdk.AccAddress, tokenScales TokenScales) MsgSetTokenScales {
+	return MsgSetTokenScales{
+		Admin:       admin,
+		TokenScales: tokenScales,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetTokenScales) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetTokenScales) Type() string { return "set_token_scales" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetTokenScales) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress(msg.Admin.String())
+	}
+	return msg.TokenScales.ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetTokenScales) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetTokenScales) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
+
+// MsgSetTokenDenoms defines a message for the admin to change the ERC20 tokens that can be bridged
+type MsgSetTokenDenoms struct {
+	Admin       sdk.AccAddress `json:"admin"`
+	TokenDenoms TokenDenoms    `json:"token_denoms"`
+}
+
+// NewMsgSetTokenDenoms is a constructor function for MsgSetTokenDenoms
+func NewMsgSetTokenDenoms(admin sdk.AccAddress, tokenDenoms TokenDenoms) MsgSetTokenDenoms {
+	return MsgSetTokenDenoms{
+		Admin:       admin,
+		TokenDenoms: tokenDenoms,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSetTokenDenoms) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSetTokenDenoms) Type() string { return "set_token_denoms" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSetTokenDenoms) ValidateBasic() sdk.Error {
+	if msg.Admin.Empty() {
+		return sdk.ErrInvalidAddress(msg.Admin.String())
+	}
+	return msg.TokenDenoms.ValidateBasic()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetTokenDenoms) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetTokenDenoms) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Admin}
+}
@@ -0,0 +1,361 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+)
+
+const (
+	// DefaultParamspace is the paramspace of the ethbridge module
+	DefaultParamspace = ModuleName
+
+	// DefaultMintWindow is the default number of blocks over which minted amounts are summed for the mint limits
+	DefaultMintWindow int64 = 100
+
+	// DefaultReleaseDelay is the default number of blocks large transfers wait in the pending-release queue
+	DefaultReleaseDelay int64 = 100
+)
+
+// Parameter store keys
+var (
+	KeyAdmin      = []byte("Admin")
+	KeyMintWindow = []byte("MintWindow")
+	KeyMintLimits = []byte("MintLimits")
+
+	KeyGuardian        = []byte("Guardian")
+	KeyReleaseDelay    = []byte("ReleaseDelay")
+	KeyDelayThresholds = []byte("DelayThresholds")
+
+	KeyConsensusTiers = []byte("ConsensusTiers")
+
+	KeyBridgeFees = []byte("BridgeFees")
+
+	KeySignatureThreshold = []byte("SignatureThreshold")
+
+	KeyPeggyContract = []byte("PeggyContract")
+
+	KeyTokenScales = []byte("TokenScales")
+
+	KeyTokenDenoms = []byte("TokenDenoms")
+)
+
+// DefaultSignatureThreshold is the default share of the attested valset's power whose signatures complete an attestation
+var DefaultSignatureThreshold = sdk.NewDecWithPrec(67, 2)
+
+var _ params.ParamSet = &Params{}
+
+// MintLimit caps the amount of a denomination that can be minted within the mint window.
+// A zero limit means the amount is not limited.
+type MintLimit struct {
+	Denom         string  `json:"denom"`
+	DenomLimit    sdk.Int `json:"denom_limit"`
+	ReceiverLimit sdk.Int `json:"receiver_limit"`
+}
+
+// NewMintLimit returns a new MintLimit
+func NewMintLimit(denom string, denomLimit, receiverLimit sdk.Int) MintLimit {
+	return MintLimit{
+		Denom:         denom,
+		DenomLimit:    denomLimit,
+		ReceiverLimit: receiverLimit,
+	}
+}
+
+// ValidateBasic checks that the limit has a denomination and non-negative caps
+func (limit MintLimit) ValidateBasic() sdk.Error {
+	if limit.Denom == "" {
+		return ErrInvalidMintLimit(DefaultCodespace, "denom cannot be empty")
+	}
+	if limit.DenomLimit.IsNegative() {
+		return ErrInvalidMintLimit(DefaultCodespace, fmt.Sprintf("invalid denom limit for %s", limit.Denom))
+	}
+	if limit.ReceiverLimit.IsNegative() {
+		return ErrInvalidMintLimit(DefaultCodespace, fmt.Sprintf("invalid receiver limit for %s", limit.Denom))
+	}
+	return nil
+}
+
+func (limit MintLimit) String() string {
+	return fmt.Sprintf("%s: denom limit %s, receiver limit %s", limit.Denom, limit.DenomLimit, limit.ReceiverLimit)
+}
+
+// MintLimits is a list of per-denomination mint limits
+type MintLimits []MintLimit
+
+// ValidateBasic validates every limit and checks that no denomination is limited twice
+func (limits MintLimits) ValidateBasic() sdk.Error {
+	seen := make(map[string]bool)
+	for _, limit := range limits {
+		if err := limit.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen[limit.Denom] {
+			return ErrInvalidMintLimit(DefaultCodespace, fmt.Sprintf("duplicate limit for %s", limit.Denom))
+		}
+		seen[limit.Denom] = true
+	}
+	return nil
+}
+
+// Get returns the limit for a denomination, if there is one
+func (limits MintLimits) Get(denom string) (MintLimit, bool) {
+	for _, limit := range limits {
+		if limit.Denom == denom {
+			return limit, true
+		}
+	}
+	return MintLimit{}, false
+}
+
+// ConsensusTier requests a higher consensus from the oracle for claims whose amount of any denomination is above
+// the tier's threshold for that denomination
+type ConsensusTier struct {
+	Thresholds      sdk.Coins `json:"thresholds"`
+	ConsensusNeeded sdk.Dec   `json:"consensus_needed"`
+}
+
+// NewConsensusTier returns a new ConsensusTier
+func NewConsensusTier(thresholds sdk.Coins, consensusNeeded sdk.Dec) ConsensusTier {
+	return ConsensusTier{
+		Thresholds:      thresholds,
+		ConsensusNeeded: consensusNeeded,
+	}
+}
+
+// Applies returns whether the amount is above the tier's threshold for any of its denominations
+func (tier ConsensusTier) Applies(amount sdk.Coins) bool {
+	for _, coin := range amount {
+		threshold := tier.Thresholds.AmountOf(coin.Denom)
+		if threshold.IsPositive() && coin.Amount.GT(threshold) {
+			return true
+		}
+	}
+	return false
+}
+
+func (tier ConsensusTier) String() string {
+	return fmt.Sprintf("above %s: consensus %s", tier.Thresholds, tier.ConsensusNeeded)
+}
+
+// ConsensusTiers is a list of consensus tiers
+type ConsensusTiers []ConsensusTier
+
+// ConsensusNeeded returns the highest consensus of the tiers that apply to the amount, or zero if none applies
+func (tiers ConsensusTiers) ConsensusNeeded(amount sdk.Coins) sdk.Dec {
+	consensusNeeded := sdk.ZeroDec()
+	for _, tier := range tiers {
+		if tier.Applies(amount) && tier.ConsensusNeeded.GT(consensusNeeded) {
+			consensusNeeded = tier.ConsensusNeeded
+		}
+	}
+	return consensusNeeded
+}
+
+// Params defines the parameters of the ethbridge module
+type Params struct {
+	// Admin is the account authorized to pause minting, change the mint limits and release queued mints
+	Admin sdk.AccAddress `json:"admin"`
+	// MintWindow is the number of blocks over which minted amounts count towards the mint limits
+	MintWindow int64 `json:"mint_window"`
+	// MintLimits are the per-denomination and per-receiver limits on minting within the mint window
+	MintLimits MintLimits `json:"mint_limits"`
+	// Guardian is an account that, besides the admin, can cancel pending releases
+	Guardian sdk.AccAddress `json:"guardian"`
+	// ReleaseDelay is the number of blocks a large transfer waits in the pending-release queue before it is minted
+	ReleaseDelay int64 `json:"release_delay"`
+	// DelayThresholds are the per-denomination amounts above which a transfer is delayed
+	DelayThresholds sdk.Coins `json:"delay_thresholds"`
+	// ConsensusTiers are the higher consensus thresholds requested from the oracle for large claims
+	ConsensusTiers ConsensusTiers `json:"consensus_tiers"`
+	// BridgeFees are the per-denomination fees deducted from minted coins and paid to the attesting validators
+	BridgeFees BridgeFees `json:"bridge_fees"`
+	// SignatureThreshold is the share of the attested valset's power whose ethereum signatures complete an attestation
+	SignatureThreshold sdk.Dec `json:"signature_threshold"`
+	// PeggyContract is the address of the peggy contract whose proven LogLock logs back proven claims. Proven claims
+	// are rejected while it is empty.
+	PeggyContract string `json:"peggy_contract"`
+	// TokenScales convert claimed ethereum amounts to minted coins of fewer decimals, and back for outgoing transfers
+	TokenScales TokenScales `json:"token_scales"`
+	// TokenDenoms are the denominations claims carry for the ERC20 tokens that can be bridged
+	TokenDenoms TokenDenoms `json:"token_denoms"`
+}
+
+// NewParams creates a new Params object
+func NewParams(admin sdk.AccAddress, mintWindow int64, mintLimits MintLimits,
+	guardian sdk.AccAddress, releaseDelay int64, delayThresholds sdk.Coins, consensusTiers ConsensusTiers,
+	bridgeFees BridgeFees, signatureThreshold sdk.Dec, peggyContract string, tokenScales TokenScales,
+	tokenDenoms TokenDenoms) Params {
+	return Params{
+		Admin:           admin,
+		MintWindow:      mintWindow,
+		MintLimits:      mintLimits,
+		Guardian:        guardian,
+		ReleaseDelay:    releaseDelay,
+		DelayThresholds: delayThresholds,
+		ConsensusTiers:  consensusTiers,
+		BridgeFees:      bridgeFees,
+
+		SignatureThreshold: signatureThreshold,
+		PeggyContract:      peggyContract,
+		TokenScales:        tokenScales,
+		TokenDenoms:        tokenDenoms,
+	}
+}
+
+// DefaultParams returns params without an admin, guardian, mint limits, delay thresholds, consensus tiers, fees,
+// peggy contract, token scales or bridged ERC20 tokens
+func DefaultParams() Params {
+	return NewParams(nil, DefaultMintWindow, MintLimits{}, nil, DefaultReleaseDelay, sdk.Coins{}, ConsensusTiers{},
+		BridgeFees{}, DefaultSignatureThreshold, "", TokenScales{}, TokenDenoms{})
+}
+
+// ParamKeyTable returns the key table for the ethbridge module params
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyAdmin, Value: &p.Admin},
+		{Key: KeyMintWindow, Value: &p.MintWindow},
+		{Key: KeyMintLimits, Value: &p.MintLimits},
+		{Key: KeyGuardian, Value: &p.Guardian},
+		{Key: KeyReleaseDelay, Value: &p.ReleaseDelay},
+		{Key: KeyDelayThresholds, Value: &p.DelayThresholds},
+		{Key: KeyConsensusTiers, Value: &p.ConsensusTiers},
+		{Key: KeyBridgeFees, Value: &p.BridgeFees},
+		{Key: KeySignatureThreshold, Value: &p.SignatureThreshold},
+		{Key: KeyPeggyContract, Value: &p.PeggyContract},
+		{Key: KeyTokenScales, Value: &p.TokenScales},
+		{Key: KeyTokenDenoms, Value: &p.TokenDenoms},
+	}
+}
+
+// Validate checks that the params are consistent
+func (p Params) Validate() error {
+	if p.MintWindow <= 0 {
+		return fmt.Errorf("mint window must be positive, is %d", p.MintWindow)
+	}
+	if err := p.MintLimits.ValidateBasic(); err != nil {
+		return err
+	}
+	if p.ReleaseDelay < 0 {
+		return fmt.Errorf("release delay cannot be negative, is %d", p.ReleaseDelay)
+	}
+	if !p.DelayThresholds.IsValid() {
+		return fmt.Errorf("invalid delay thresholds %s", p.DelayThresholds)
+	}
+	for _, tier := range p.ConsensusTiers {
+		if !tier.Thresholds.IsValid() {
+			return fmt.Errorf("invalid consensus tier thresholds %s", tier.Thresholds)
+		}
+		if !tier.ConsensusNeeded.IsPositive() || tier.ConsensusNeeded.GT(sdk.OneDec()) {
+			return fmt.Errorf("consensus tier consensus must be > 0 and <= 1, is %s", tier.ConsensusNeeded)
+		}
+	}
+	if err := p.BridgeFees.ValidateBasic(); err != nil {
+		return err
+	}
+	if !p.SignatureThreshold.IsPositive() || p.SignatureThreshold.GT(sdk.OneDec()) {
+		return fmt.Errorf("signature threshold must be > 0 and <= 1, is %s", p.SignatureThreshold)
+	}
+	if p.PeggyContract != "" && !common.IsValidEthAddress(p.PeggyContract) {
+		return fmt.Errorf("invalid peggy contract address %s", p.PeggyContract)
+	}
+	if err := p.TokenScales.ValidateBasic(); err != nil {
+		return err
+	}
+	if err := p.TokenDenoms.ValidateBasic(); err != nil {
+		return err
+	}
+	// The mint limits, delay thresholds, consensus tiers and bridge fees apply to the minted coins, so naming the
+	// ethereum denomination of a scaled token, which is never minted, would leave that token unguarded
+	for _, scale := range p.TokenScales {
+		if scale.EthereumDenom == scale.CosmosDenom {
+			continue
+		}
+		if field := p.unmintedDenomField(scale.EthereumDenom); field != "" {
+			return fmt.Errorf("%s name %s, which is minted as %s", field, scale.EthereumDenom, scale.CosmosDenom)
+		}
+	}
+	return nil
+}
+
+// unmintedDenomField returns the first of the fields applying to minted coins that names a denomination
+func (p Params) unmintedDenomField(denom string) string {
+	if _, found := p.MintLimits.Get(denom); found {
+		return "mint limits"
+	}
+	if p.DelayThresholds.AmountOf(denom).IsPositive() {
+		return "delay thresholds"
+	}
+	for _, tier := range p.ConsensusTiers {
+		if tier.Thresholds.AmountOf(denom).IsPositive() {
+			return "consensus tiers"
+		}
+	}
+	for _, fee := range p.BridgeFees {
+		if fee.Denom == denom {
+			return "bridge fees"
+		}
+	}
+	return ""
+}
+
+// ExceedsDelayThreshold returns whether any coin of the amount is above the delay threshold of its denomination
+func (p Params) ExceedsDelayThreshold(amount sdk.Coins) bool {
+	for _, coin := range amount {
+		threshold := p.DelayThresholds.AmountOf(coin.Denom)
+		if threshold.IsPositive() && coin.Amount.GT(threshold) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Params) String() string {
+	limits := make([]string, len(p.MintLimits))
+	for i, limit := range p.MintLimits {
+		limits[i] = "  " + limit.String()
+	}
+	tiers := make([]string, len(p.ConsensusTiers))
+	for i, tier := range p.ConsensusTiers {
+		tiers[i] = "  " + tier.String()
+	}
+	fees := make([]string, len(p.BridgeFees))
+	for i, fee := range p.BridgeFees {
+		fees[i] = "  " + fee.String()
+	}
+	scales := make([]string, len(p.TokenScales))
+	for i, scale := range p.TokenScales {
+		scales[i] = "  " + scale.String()
+	}
+	tokenDenoms := make([]string, len(p.TokenDenoms))
+	for i, tokenDenom := range p.TokenDenoms {
+		tokenDenoms[i] = "  " + tokenDenom.String()
+	}
+	return strings.TrimSpace(fmt.Sprintf(`Admin:               %s
+Mint Window:         %d
+Mint Limits:
+%s
+Guardian:            %s
+Release Delay:       %d
+Delay Thresholds:    %s
+Consensus Tiers:
+%s
+Bridge Fees:
+%s
+Signature Threshold: %s
+Peggy Contract:      %s
+Token Scales:
+%s
+Token Denoms:
+%s`, p.Admin, p.MintWindow, strings.Join(limits, "\n"), p.Guardian, p.ReleaseDelay, p.DelayThresholds,
+		strings.Join(tiers, "\n"), strings.Join(fees, "\n"), p.SignatureThreshold, p.PeggyContract,
+		strings.Join(scales, "\n"), strings.Join(tokenDenoms, "\n")))
+}
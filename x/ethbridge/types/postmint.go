@@ -0,0 +1,193 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// MemoSeparator separates the cosmos receiver from the post-mint memo in the recipient bytes of a peggy lock
+	MemoSeparator = "|"
+
+	// PostMintActionSend sends the minted coins on to another address
+	PostMintActionSend = "send"
+	// PostMintActionDelegate delegates the minted coins of the bond denomination to a validator
+	PostMintActionDelegate = "delegate"
+
+	// MaxPostMintActions is the most actions a memo can ask for
+	MaxPostMintActions = 4
+
+	PostMintStatusPending  = "pending"
+	PostMintStatusExecuted = "executed"
+	PostMintStatusFailed   = "failed"
+)
+
+// PostMintAction is a whitelisted action run on behalf of the receiver once the coins of a claim are minted.
+// Without an amount the action takes everything the earlier actions left of the received coins.
+type PostMintAction struct {
+	Type             string         `json:"type"`
+	ToAddress        sdk.AccAddress `json:"to_address,omitempty"`
+	ValidatorAddress sdk.ValAddress `json:"validator_address,omitempty"`
+	Amount           sdk.Coins      `json:"amount,omitempty"`
+}
+
+// NewPostMintAction returns a new PostMintAction
+func NewPostMintAction(actionType string, toAddress sdk.AccAddress, validatorAddress sdk.ValAddress, amount sdk.Coins) PostMintAction {
+	return PostMintAction{
+		Type:             actionType,
+		ToAddress:        toAddress,
+		ValidatorAddress: validatorAddress,
+		Amount:           amount,
+	}
+}
+
+// ValidateBasic checks that the action is whitelisted and names only what its type needs
+func (action PostMintAction) ValidateBasic() sdk.Error {
+	switch action.Type {
+	case PostMintActionSend:
+		if action.ToAddress.Empty() || !action.ValidatorAddress.Empty() {
+			return ErrInvalidPostMintAction(DefaultCodespace, "send needs a to address and no validator")
+		}
+	case PostMintActionDelegate:
+		if action.ValidatorAddress.Empty() || !action.ToAddress.Empty() {
+			return ErrInvalidPostMintAction(DefaultCodespace, "delegate needs a validator and no to address")
+		}
+		if len(action.Amount) > 1 {
+			return ErrInvalidPostMintAction(DefaultCodespace, "delegate takes a single coin")
+		}
+	default:
+		return ErrInvalidPostMintAction(DefaultCodespace, fmt.Sprintf("unknown action %s", action.Type))
+	}
+	if !action.Amount.IsValid() {
+		return ErrInvalidPostMintAction(DefaultCodespace, fmt.Sprintf("invalid amount %s", action.Amount))
+	}
+	return nil
+}
+
+func (action PostMintAction) String() string {
+	amount := "all"
+	if !action.Amount.Empty() {
+		amount = action.Amount.String()
+	}
+	if action.Type == PostMintActionDelegate {
+		return fmt.Sprintf("delegate %s to %s", amount, action.ValidatorAddress)
+	}
+	return fmt.Sprintf("%s %s to %s", action.Type, amount, action.ToAddress)
+}
+
+// PostMintActions is the list of actions of a claim, run in order
+type PostMintActions []PostMintAction
+
+// ValidateBasic checks every action and the number of actions
+func (actions PostMintActions) ValidateBasic() sdk.Error {
+	if len(actions) > MaxPostMintActions {
+		return ErrInvalidPostMintAction(DefaultCodespace, fmt.Sprintf("at most %d actions are allowed", MaxPostMintActions))
+	}
+	for _, action := range actions {
+		if err := action.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Equal returns whether both lists hold the same actions in the same order
+func (actions PostMintActions) Equal(other PostMintActions) bool {
+	if len(actions) != len(other) {
+		return false
+	}
+	a, _ := json.Marshal(actions)
+	b, _ := json.Marshal(other)
+	return bytes.Equal(a, b)
+}
+
+func (actions PostMintActions) String() string {
+	out := make([]string, len(actions))
+	for i, action := range actions {
+		out[i] = action.String()
+	}
+	return strings.Join(out, ", ")
+}
+
+// PostMintMemo is the structured memo a sender can append to the recipient of a peggy lock, eg.
+// cosmos1...|{"actions":[{"type":"delegate","validator_address":"cosmosvaloper1..."}]}
+type PostMintMemo struct {
+	Actions PostMintActions `json:"actions"`
+}
+
+// ParseRecipient splits the recipient bytes of a peggy lock into the cosmos receiver and the actions of its memo
+func ParseRecipient(recipient []byte) (sdk.AccAddress, PostMintActions, error) {
+	parts := strings.SplitN(string(recipient), MemoSeparator, 2)
+	receiver, err := sdk.AccAddressFromBech32(parts[0])
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(parts) == 1 {
+		return receiver, nil, nil
+	}
+
+	var memo PostMintMemo
+	decoder := json.NewDecoder(strings.NewReader(parts[1]))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&memo); err != nil {
+		return nil, nil, fmt.Errorf("invalid memo: %s", err)
+	}
+	if err := memo.Actions.ValidateBasic(); err != nil {
+		return nil, nil, err
+	}
+	return receiver, memo.Actions, nil
+}
+
+// FormatRecipient returns the recipient bytes that carry the receiver and the actions to peggy
+func FormatRecipient(receiver sdk.AccAddress, actions PostMintActions) []byte {
+	if len(actions) == 0 {
+		return []byte(receiver.String())
+	}
+	memo, _ := json.Marshal(PostMintMemo{Actions: actions})
+	return []byte(receiver.String() + MemoSeparator + string(memo))
+}
+
+// PostMint is the record of the post-mint actions of a successful claim: pending until its coins are minted, then
+// executed, or failed with the coins left with the receiver
+type PostMint struct {
+	ItemID   string          `json:"item_id"`
+	Receiver sdk.AccAddress  `json:"receiver"`
+	Actions  PostMintActions `json:"actions"`
+	Status   string          `json:"status"`
+	Log      string          `json:"log,omitempty"`
+	Height   int64           `json:"height"`
+}
+
+// NewPostMint returns a new pending PostMint
+func NewPostMint(itemID string, receiver sdk.AccAddress, actions PostMintActions, height int64) PostMint {
+	return PostMint{
+		ItemID:   itemID,
+		Receiver: receiver,
+		Actions:  actions,
+		Status:   PostMintStatusPending,
+		Height:   height,
+	}
+}
+
+func (postMint PostMint) String() string {
+	out := fmt.Sprintf("%s: %s for %s (%s)", postMint.ItemID, postMint.Actions, postMint.Receiver, postMint.Status)
+	if postMint.Log != "" {
+		out += ": " + postMint.Log
+	}
+	return out
+}
+
+// PostMints is a list of PostMint
+type PostMints []PostMint
+
+func (postMints PostMints) String() string {
+	out := make([]string, len(postMints))
+	for i, postMint := range postMints {
+		out[i] = postMint.String()
+	}
+	return strings.Join(out, "\n")
+}
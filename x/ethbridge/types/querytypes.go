@@ -4,35 +4,48 @@ import (
 	"encoding/json"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle"
 )
 
 // defines the params for the following queries:
 // - 'custom/ethbridge/prophecies/'
 type QueryEthProphecyParams struct {
-	Nonce          int
-	EthereumSender string
+	ItemID string
 }
 
-func NewQueryEthProphecyParams(nonce int, ethereumSender string) QueryEthProphecyParams {
+func NewQueryEthProphecyParams(itemID string) QueryEthProphecyParams {
 	return QueryEthProphecyParams{
-		Nonce:          nonce,
-		EthereumSender: ethereumSender,
+		ItemID: itemID,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/ethbridge/txProphecies/'
+type QueryEthProphecyByTxParams struct {
+	EthereumTxHash string
+}
+
+func NewQueryEthProphecyByTxParams(ethereumTxHash string) QueryEthProphecyByTxParams {
+	return QueryEthProphecyByTxParams{
+		EthereumTxHash: ethereumTxHash,
 	}
 }
 
 // Query Result Payload for an eth prophecy query
 type QueryEthProphecyResponse struct {
-	ID              string           `json:"id"`
-	Status          oracle.Status    `json:"status"`
-	EthBridgeClaims []EthBridgeClaim `json:"claims"`
+	ID                string                   `json:"id"`
+	Status            oracle.Status            `json:"status"`
+	ConsensusProgress oracle.ConsensusProgress `json:"consensus_progress"`
+	EthBridgeClaims   []EthBridgeClaim         `json:"claims"`
 }
 
-func NewQueryEthProphecyResponse(id string, status oracle.Status, claims []EthBridgeClaim) QueryEthProphecyResponse {
+func NewQueryEthProphecyResponse(id string, status oracle.Status, progress oracle.ConsensusProgress, claims []EthBridgeClaim) QueryEthProphecyResponse {
 	return QueryEthProphecyResponse{
-		ID:              id,
-		Status:          status,
-		EthBridgeClaims: claims,
+		ID:                id,
+		Status:            status,
+		ConsensusProgress: progress,
+		EthBridgeClaims:   claims,
 	}
 }
 
@@ -44,3 +57,63 @@ func (response QueryEthProphecyResponse) String() string {
 
 	return string(prophecyJSON)
 }
+
+// Query Result Payload for a query of all prophecies claimed for an ethereum transaction
+type QueryEthProphecyByTxResponse []QueryEthProphecyResponse
+
+func (response QueryEthProphecyByTxResponse) String() string {
+	prophecyJSON, err := json.Marshal(response)
+	if err != nil {
+		return fmt.Sprintf("Error marshalling json: %v", err)
+	}
+
+	return string(prophecyJSON)
+}
+
+// defines the params for the following queries:
+// - 'custom/ethbridge/supply/'
+type QuerySupplyParams struct {
+	Denom string
+}
+
+func NewQuerySupplyParams(denom string) QuerySupplyParams {
+	return QuerySupplyParams{
+		Denom: denom,
+	}
+}
+
+// defines the params for the following queries:
+// - 'custom/ethbridge/feeRewards/'
+type QueryFeeRewardsParams struct {
+	Validator sdk.ValAddress
+}
+
+func NewQueryFeeRewardsParams(validator sdk.ValAddress) QueryFeeRewardsParams {
+	return QueryFeeRewardsParams{
+		Validator: validator,
+	}
+}
+
+type QueryEthereumKeysParams struct {
+	Validator sdk.ValAddress
+}
+
+func NewQueryEthereumKeysParams(validator sdk.ValAddress) QueryEthereumKeysParams {
+	return QueryEthereumKeysParams{
+		Validator: validator,
+	}
+}
+
+// QueryAttestationsParams selects the attestations of a kind, or the attestation of a kind and nonce when Nonce is
+// set. No kind selects every attestation.
+type QueryAttestationsParams struct {
+	Kind  string
+	Nonce uint64
+}
+
+func NewQueryAttestationsParams(kind string, nonce uint64) QueryAttestationsParams {
+	return QueryAttestationsParams{
+		Kind:  kind,
+		Nonce: nonce,
+	}
+}
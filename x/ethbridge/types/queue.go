@@ -0,0 +1,99 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Reasons for a successful claim being queued instead of minted
+const (
+	QueueReasonPaused      = "minting_paused"
+	QueueReasonRateLimited = "rate_limited"
+	// QueueReasonReleaseFailed is a pending release whose mint failed once its release height was reached
+	QueueReasonReleaseFailed = "release_failed"
+)
+
+// QueuedMint is a successful claim whose coins were held back by the circuit breaker or the mint limits, or
+// whose delayed release failed. It is minted once the admin releases it.
+type QueuedMint struct {
+	ItemID   string         `json:"item_id"`
+	Receiver sdk.AccAddress `json:"receiver"`
+	Amount   sdk.Coins      `json:"amount"`
+	Reason   string         `json:"reason"`
+	Height   int64          `json:"height"`
+}
+
+// NewQueuedMint returns a new QueuedMint
+func NewQueuedMint(itemID string, receiver sdk.AccAddress, amount sdk.Coins, reason string, height int64) QueuedMint {
+	return QueuedMint{
+		ItemID:   itemID,
+		Receiver: receiver,
+		Amount:   amount,
+		Reason:   reason,
+		Height:   height,
+	}
+}
+
+func (mint QueuedMint) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Item ID:  %s
+Receiver: %s
+Amount:   %s
+Reason:   %s
+Height:   %d`, mint.ItemID, mint.Receiver, mint.Amount, mint.Reason, mint.Height))
+}
+
+// QueuedMints is a list of queued mints
+type QueuedMints []QueuedMint
+
+func (mints QueuedMints) String() string {
+	out := make([]string, len(mints))
+	for i, mint := range mints {
+		out[i] = mint.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// MintRecord is a mint counted towards the mint limits until it falls out of the mint window
+type MintRecord struct {
+	Receiver sdk.AccAddress `json:"receiver"`
+	Amount   sdk.Coins      `json:"amount"`
+}
+
+// PendingRelease is a successful claim above the delay threshold of one of its denominations. It is minted once
+// the release height is reached, unless the admin or guardian cancels it before then.
+type PendingRelease struct {
+	ItemID        string         `json:"item_id"`
+	Receiver      sdk.AccAddress `json:"receiver"`
+	Amount        sdk.Coins      `json:"amount"`
+	ReleaseHeight int64          `json:"release_height"`
+}
+
+// NewPendingRelease returns a new PendingRelease
+func NewPendingRelease(itemID string, receiver sdk.AccAddress, amount sdk.Coins, releaseHeight int64) PendingRelease {
+	return PendingRelease{
+		ItemID:        itemID,
+		Receiver:      receiver,
+		Amount:        amount,
+		ReleaseHeight: releaseHeight,
+	}
+}
+
+func (release PendingRelease) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Item ID:        %s
+Receiver:       %s
+Amount:         %s
+Release Height: %d`, release.ItemID, release.Receiver, release.Amount, release.ReleaseHeight))
+}
+
+// PendingReleases is a list of pending releases
+type PendingReleases []PendingRelease
+
+func (releases PendingReleases) String() string {
+	out := make([]string, len(releases))
+	for i, release := range releases {
+		out[i] = release.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Tags added to the result of a claim that failed its lock prophecy, so relayers can find the refunds to send
+const (
+	TagRefundItemID = "refund_item_id"
+	TagRefundSender = "refund_sender"
+)
+
+// Refund is a peggy item whose lock prophecy failed. Its funds stay locked in peggy until they are unlocked back
+// to the original sender, and the refund is removed from the queue once validators attest the unlock or withdrawal.
+type Refund struct {
+	ItemID         string    `json:"item_id"`
+	EthereumSender string    `json:"ethereum_sender"`
+	EthereumTxHash string    `json:"ethereum_tx_hash"`
+	Amount         sdk.Coins `json:"amount"`
+	Height         int64     `json:"height"`
+}
+
+// NewRefund returns a new Refund
+func NewRefund(itemID string, ethereumSender string, ethereumTxHash string, amount sdk.Coins, height int64) Refund {
+	return Refund{
+		ItemID:         itemID,
+		EthereumSender: ethereumSender,
+		EthereumTxHash: ethereumTxHash,
+		Amount:         amount,
+		Height:         height,
+	}
+}
+
+// Tags returns the tags signaling the refund
+func (refund Refund) Tags() sdk.Tags {
+	return sdk.NewTags(
+		TagRefundItemID, refund.ItemID,
+		TagRefundSender, refund.EthereumSender,
+	)
+}
+
+func (refund Refund) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Item ID: %s
+Sender:  %s
+Tx Hash: %s
+Amount:  %s
+Height:  %d`, refund.ItemID, refund.EthereumSender, refund.EthereumTxHash, refund.Amount, refund.Height))
+}
+
+// Refunds is a list of refunds
+type Refunds []Refund
+
+func (refunds Refunds) String() string {
+	out := make([]string, len(refunds))
+	for i, refund := range refunds {
+		out[i] = refund.String()
+	}
+	return strings.Join(out, "\n")
+}
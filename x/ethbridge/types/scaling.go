@@ -0,0 +1,199 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Dust rules for the part of a claimed amount below one unit of the minted denomination
+const (
+	// DustRuleReject rejects claims whose amount does not convert exactly
+	DustRuleReject = "reject"
+
+	// DustRuleRoundDown mints the amount rounded down and records the remainder
+	DustRuleRoundDown = "round_down"
+
+	// MaxTokenDecimals is the largest number of decimals of a scaled token
+	MaxTokenDecimals = 36
+)
+
+// TokenScale converts the amounts of a token claimed in its ethereum base units (eg. 18 decimal wei) to the coins
+// minted for it (eg. 6 decimal micro units), and back for outgoing transfers
+type TokenScale struct {
+	EthereumDenom    string `json:"ethereum_denom"`
+	CosmosDenom      string `json:"cosmos_denom"`
+	EthereumDecimals uint8  `json:"ethereum_decimals"`
+	CosmosDecimals   uint8  `json:"cosmos_decimals"`
+	DustRule         string `json:"dust_rule"`
+}
+
+// NewTokenScale returns a new TokenScale
+func NewTokenScale(ethereumDenom, cosmosDenom string, ethereumDecimals, cosmosDecimals uint8, dustRule string) TokenScale {
+	return TokenScale{
+		EthereumDenom:    ethereumDenom,
+		CosmosDenom:      cosmosDenom,
+		EthereumDecimals: ethereumDecimals,
+		CosmosDecimals:   cosmosDecimals,
+		DustRule:         dustRule,
+	}
+}
+
+// ValidateBasic checks that the scale has both denominations, a known dust rule, and no more decimals on cosmos
+// than on ethereum, so that converting back to ethereum is always exact
+func (scale TokenScale) ValidateBasic() sdk.Error {
+	for _, denom := range []string{scale.EthereumDenom, scale.CosmosDenom} {
+		if !(sdk.Coins{sdk.Coin{Denom: denom, Amount: sdk.OneInt()}}).IsValid() {
+			return ErrInvalidTokenScale(DefaultCodespace, fmt.Sprintf("invalid denom %s", denom))
+		}
+	}
+	if scale.EthereumDecimals > MaxTokenDecimals || scale.CosmosDecimals > scale.EthereumDecimals {
+		return ErrInvalidTokenScale(DefaultCodespace, fmt.Sprintf("decimals of %s must be <= %d and at least the cosmos decimals",
+			scale.EthereumDenom, MaxTokenDecimals))
+	}
+	if scale.DustRule != DustRuleReject && scale.DustRule != DustRuleRoundDown {
+		return ErrInvalidTokenScale(DefaultCodespace, fmt.Sprintf("dust rule must be %s or %s", DustRuleReject, DustRuleRoundDown))
+	}
+	return nil
+}
+
+// factor returns the number of ethereum base units in one unit of the cosmos denomination
+func (scale TokenScale) factor() sdk.Int {
+	factor := sdk.OneInt()
+	for i := scale.CosmosDecimals; i < scale.EthereumDecimals; i++ {
+		factor = factor.MulRaw(10)
+	}
+	return factor
+}
+
+// ToCosmos converts an amount of ethereum base units, returning the cosmos amount rounded down and the remainder
+// in ethereum base units
+func (scale TokenScale) ToCosmos(amount sdk.Int) (sdk.Int, sdk.Int) {
+	factor := scale.factor()
+	scaled := amount.Quo(factor)
+	return scaled, amount.Sub(scaled.Mul(factor))
+}
+
+// ToEthereum converts a cosmos amount to ethereum base units
+func (scale TokenScale) ToEthereum(amount sdk.Int) sdk.Int {
+	return amount.Mul(scale.factor())
+}
+
+func (scale TokenScale) String() string {
+	return fmt.Sprintf("%s (%d decimals) -> %s (%d decimals), dust: %s", scale.EthereumDenom, scale.EthereumDecimals,
+		scale.CosmosDenom, scale.CosmosDecimals, scale.DustRule)
+}
+
+// TokenScales is a list of per-token scales. Tokens without a scale are minted one to one in their ethereum
+// denomination.
+type TokenScales []TokenScale
+
+// ValidateBasic validates every scale and checks that no denomination is scaled twice
+func (scales TokenScales) ValidateBasic() sdk.Error {
+	seen := make(map[string]bool)
+	for _, scale := range scales {
+		if err := scale.ValidateBasic(); err != nil {
+			return err
+		}
+		if seen["eth:"+scale.EthereumDenom] || seen["cosmos:"+scale.CosmosDenom] {
+			return ErrInvalidTokenScale(DefaultCodespace, fmt.Sprintf("duplicate scale for %s", scale.EthereumDenom))
+		}
+		seen["eth:"+scale.EthereumDenom] = true
+		seen["cosmos:"+scale.CosmosDenom] = true
+	}
+	return nil
+}
+
+// ToCosmos converts claimed ethereum amounts to the coins to mint, returning the remainders that are dust. Claims
+// with dust under the reject rule, or with an amount below one minted unit, are rejected, as are malformed amounts.
+func (scales TokenScales) ToCosmos(amount sdk.Coins) (sdk.Coins, sdk.Coins, sdk.Error) {
+	if !amount.IsValid() || !amount.IsAllPositive() {
+		return nil, nil, sdk.ErrInvalidCoins(amount.String())
+	}
+	var scaled, dust sdk.Coins
+	for _, coin := range amount {
+		scale, found := scales.byEthereumDenom(coin.Denom)
+		if !found {
+			if _, minted := scales.byCosmosDenom(coin.Denom); minted {
+				return nil, nil, ErrInvalidTokenScale(DefaultCodespace, fmt.Sprintf("%s can only be minted from its ethereum denom", coin.Denom))
+			}
+			scaled = append(scaled, coin)
+			continue
+		}
+		cosmosAmount, remainder := scale.ToCosmos(coin.Amount)
+		if !cosmosAmount.IsPositive() {
+			return nil, nil, ErrDustAmount(DefaultCodespace, fmt.Sprintf("%s is less than one %s", coin, scale.CosmosDenom))
+		}
+		if remainder.IsPositive() {
+			if scale.DustRule == DustRuleReject {
+				return nil, nil, ErrDustAmount(DefaultCodespace, fmt.Sprintf("%s is not a whole amount of %s", coin, scale.CosmosDenom))
+			}
+			dust = append(dust, sdk.NewCoin(coin.Denom, remainder))
+		}
+		scaled = append(scaled, sdk.NewCoin(scale.CosmosDenom, cosmosAmount))
+	}
+	scaled, dust = scaled.Sort(), dust.Sort()
+	if !scaled.IsValid() || !dust.IsValid() {
+		return nil, nil, sdk.ErrInvalidCoins(fmt.Sprintf("%s scales to %s", amount, scaled))
+	}
+	return scaled, dust, nil
+}
+
+// ToEthereum converts a coin to the ethereum denomination and base units it is transferred in
+func (scales TokenScales) ToEthereum(coin sdk.Coin) sdk.Coin {
+	if scale, found := scales.byCosmosDenom(coin.Denom); found {
+		return sdk.NewCoin(scale.EthereumDenom, scale.ToEthereum(coin.Amount))
+	}
+	return coin
+}
+
+func (scales TokenScales) byEthereumDenom(denom string) (TokenScale, bool) {
+	for _, scale := range scales {
+		if scale.EthereumDenom == denom {
+			return scale, true
+		}
+	}
+	return TokenScale{}, false
+}
+
+func (scales TokenScales) byCosmosDenom(denom string) (TokenScale, bool) {
+	for _, scale := range scales {
+		if scale.CosmosDenom == denom {
+			return scale, true
+		}
+	}
+	return TokenScale{}, false
+}
+
+// Dust is the remainder of a successful claim's amount that was too small to be minted under the round down rule.
+// It stays locked on ethereum.
+type Dust struct {
+	ItemID string    `json:"item_id"`
+	Amount sdk.Coins `json:"amount"`
+	Height int64     `json:"height"`
+}
+
+// NewDust returns a new Dust
+func NewDust(itemID string, amount sdk.Coins, height int64) Dust {
+	return Dust{
+		ItemID: itemID,
+		Amount: amount,
+		Height: height,
+	}
+}
+
+func (dust Dust) String() string {
+	return fmt.Sprintf("%s: %s at height %d", dust.ItemID, dust.Amount, dust.Height)
+}
+
+// Dusts is a list of Dust
+type Dusts []Dust
+
+func (dusts Dusts) String() string {
+	out := make([]string, len(dusts))
+	for i, dust := range dusts {
+		out[i] = dust.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto"
+)
+
+// ModuleAddress is the address of the ethbridge module account. Bridged coins are minted into and burned from this account.
+var ModuleAddress = sdk.AccAddress(crypto.AddressHash([]byte(ModuleName)))
+
+// Supply tracks how much of a bridged denomination has been minted and burned by the ethbridge module
+type Supply struct {
+	Denom       string  `json:"denom"`
+	Minted      sdk.Int `json:"minted"`
+	Burned      sdk.Int `json:"burned"`
+	Outstanding sdk.Int `json:"outstanding"`
+}
+
+// NewSupply returns an empty Supply for the given denomination
+func NewSupply(denom string) Supply {
+	return Supply{
+		Denom:       denom,
+		Minted:      sdk.ZeroInt(),
+		Burned:      sdk.ZeroInt(),
+		Outstanding: sdk.ZeroInt(),
+	}
+}
+
+// Mint records newly minted coins
+func (supply Supply) Mint(amount sdk.Int) Supply {
+	supply.Minted = supply.Minted.Add(amount)
+	supply.Outstanding = supply.Outstanding.Add(amount)
+	return supply
+}
+
+// Burn records burned coins
+func (supply Supply) Burn(amount sdk.Int) Supply {
+	supply.Burned = supply.Burned.Add(amount)
+	supply.Outstanding = supply.Outstanding.Sub(amount)
+	return supply
+}
+
+func (supply Supply) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Denom:       %s
+Minted:      %s
+Burned:      %s
+Outstanding: %s`, supply.Denom, supply.Minted, supply.Burned, supply.Outstanding))
+}
+
+// Supplies is a list of per-denomination supplies
+type Supplies []Supply
+
+func (supplies Supplies) String() string {
+	out := make([]string, len(supplies))
+	for i, supply := range supplies {
+		out[i] = supply.String()
+	}
+	return strings.Join(out, "\n")
+}
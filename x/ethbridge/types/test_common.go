@@ -14,6 +14,10 @@ import (
 const (
 	TestAddress            = "cosmos1gn8409qq9hnrxde37kuxwx5hrxpfpv8426szuv"
 	TestValidator          = "cosmos1xdp5tvt7lxh8rf9xx07wy2xlagzhq24ha48xtq"
+	TestItemID             = "0xab85e2ceaa7d100af2f07cac01365f3777153a4e004342dca5db44e731b9d461"
+	AltTestItemID          = "0xab85e2ceaa7d100af2f07cac01365f3777153a4e004342dca5db44e731b9d462"
+	TestEthereumTxHash     = "0x6bc6fa7d1e5bcd1f0c5b4ac2f8bfd3c8c4bb0aa2fb3e2b1d7b4e8c4a9d3f1e20"
+	TestEthereumLogIndex   = 3
 	TestNonce              = 0
 	TestEthereumAddress    = "0x7B95B6EC7EbD73572298cEf32Bb54FA408207359"
 	AltTestEthereumAddress = "0x7B95B6EC7EbD73572298cEf32Bb54FA408207344"
@@ -21,7 +25,7 @@ const (
 	AltTestCoins           = "12ethereum"
 )
 
-//Ethereum-bridge specific stuff
+// Ethereum-bridge specific stuff
 func CreateTestEthMsg(t *testing.T, validatorAddress sdk.AccAddress) MsgMakeEthBridgeClaim {
 	ethClaim := CreateTestEthClaim(t, validatorAddress, TestEthereumAddress, TestCoins)
 	ethMsg := NewMsgMakeEthBridgeClaim(ethClaim)
@@ -33,14 +37,14 @@ func CreateTestEthClaim(t *testing.T, validatorAddress sdk.AccAddress, testEther
 	amount, err2 := sdk.ParseCoins(coins)
 	require.NoError(t, err1)
 	require.NoError(t, err2)
-	ethClaim := NewEthBridgeClaim(TestNonce, testEthereumAddress, testCosmosAddress, validatorAddress, amount)
+	ethClaim := NewEthBridgeClaim(TestItemID, TestEthereumTxHash, TestEthereumLogIndex, TestNonce, testEthereumAddress, testCosmosAddress, validatorAddress, amount, nil)
 	return ethClaim
 }
 
-func CreateTestQueryEthProphecyResponse(cdc *codec.Codec, t *testing.T, validatorAddress sdk.AccAddress) QueryEthProphecyResponse {
+func CreateTestQueryEthProphecyResponse(cdc *codec.Codec, t *testing.T, validatorAddress sdk.AccAddress, progress oracle.ConsensusProgress) QueryEthProphecyResponse {
 	ethBridgeClaim := CreateTestEthClaim(t, validatorAddress, TestEthereumAddress, TestCoins)
 	id, _, _ := CreateOracleClaimFromEthClaim(cdc, ethBridgeClaim)
 	ethBridgeClaims := []EthBridgeClaim{ethBridgeClaim}
-	resp := NewQueryEthProphecyResponse(id, oracle.Status{oracle.PendingStatus, ""}, ethBridgeClaims)
+	resp := NewQueryEthProphecyResponse(id, oracle.Status{StatusText: oracle.PendingStatus, FinalClaim: ""}, progress, ethBridgeClaims)
 	return resp
 }
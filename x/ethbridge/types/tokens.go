@@ -0,0 +1,71 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+)
+
+// EtherDenom is the denomination claims carry for ether, which peggy logs as the zero token address
+const EtherDenom = "ethereum"
+
+// TokenDenom is the denomination claims carry for an ERC20 token locked in peggy
+type TokenDenom struct {
+	Token string `json:"token"`
+	Denom string `json:"denom"`
+}
+
+// NewTokenDenom returns a new TokenDenom
+func NewTokenDenom(token string, denom string) TokenDenom {
+	return TokenDenom{
+		Token: token,
+		Denom: denom,
+	}
+}
+
+func (tokenDenom TokenDenom) String() string {
+	return fmt.Sprintf("%s: %s", tokenDenom.Token, tokenDenom.Denom)
+}
+
+// TokenDenoms are the ERC20 tokens that can be bridged. Locks of any other token are not claimed.
+type TokenDenoms []TokenDenom
+
+// ValidateBasic checks that every token is a non-zero address with a valid denomination other than ether's, and
+// that no token or denomination is listed twice
+func (tokenDenoms TokenDenoms) ValidateBasic() sdk.Error {
+	tokens := map[gethCommon.Address]bool{}
+	denoms := map[string]bool{}
+	for _, tokenDenom := range tokenDenoms {
+		if !common.IsValidEthAddress(tokenDenom.Token) ||
+			gethCommon.HexToAddress(tokenDenom.Token) == (gethCommon.Address{}) {
+			return ErrInvalidTokenDenom(DefaultCodespace, fmt.Sprintf("invalid token %s", tokenDenom.Token))
+		}
+		if !(sdk.Coins{sdk.Coin{Denom: tokenDenom.Denom, Amount: sdk.OneInt()}}).IsValid() ||
+			tokenDenom.Denom == EtherDenom {
+			return ErrInvalidTokenDenom(DefaultCodespace, fmt.Sprintf("invalid denom %s", tokenDenom.Denom))
+		}
+		token := gethCommon.HexToAddress(tokenDenom.Token)
+		if tokens[token] || denoms[tokenDenom.Denom] {
+			return ErrInvalidTokenDenom(DefaultCodespace, fmt.Sprintf("%s is listed twice", tokenDenom))
+		}
+		tokens[token] = true
+		denoms[tokenDenom.Denom] = true
+	}
+	return nil
+}
+
+// DenomOf returns the denomination claims carry for a locked token: ether's for the zero address, or the listed
+// denomination of an ERC20 token
+func (tokenDenoms TokenDenoms) DenomOf(token gethCommon.Address) (string, bool) {
+	if token == (gethCommon.Address{}) {
+		return EtherDenom, true
+	}
+	for _, tokenDenom := range tokenDenoms {
+		if gethCommon.HexToAddress(tokenDenom.Token) == token {
+			return tokenDenom.Denom, true
+		}
+	}
+	return "", false
+}
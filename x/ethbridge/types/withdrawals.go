@@ -0,0 +1,185 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethbridge/common"
+)
+
+// Kinds of peggy events that release locked funds on ethereum
+const (
+	// ReleaseKindWithdraw is a LogWithdraw, emitted when the original sender pulls back the locked funds
+	ReleaseKindWithdraw = "withdraw"
+	// ReleaseKindUnlock is a LogUnlock, emitted when the peggy relayer returns the locked funds to the sender
+	ReleaseKindUnlock = "unlock"
+)
+
+// releaseProphecySuffix is appended to a peggy item id to form the id of the oracle prophecy on its release,
+// which is kept apart from the prophecy on its lock
+const releaseProphecySuffix = "/release"
+
+// IsValidReleaseKind returns whether a release kind is known
+func IsValidReleaseKind(kind string) bool {
+	return kind == ReleaseKindWithdraw || kind == ReleaseKindUnlock
+}
+
+// GetReleaseProphecyID returns the id of the oracle prophecy on the release of a peggy item
+func GetReleaseProphecyID(itemID string) string {
+	return common.NormalizeEthHash(itemID) + releaseProphecySuffix
+}
+
+// EthBridgeReleaseClaim is a validator's claim that the locked funds of a peggy item were released on ethereum,
+// by a withdrawal of the original sender or an unlock
+type EthBridgeReleaseClaim struct {
+	ItemID           string         `json:"item_id"`
+	EthereumTxHash   string         `json:"ethereum_tx_hash"`
+	EthereumLogIndex uint64         `json:"ethereum_log_index"`
+	Kind             string         `json:"kind"`
+	EthereumReceiver string         `json:"ethereum_receiver"`
+	Validator        sdk.AccAddress `json:"validator"`
+	Amount           sdk.Coins      `json:"amount"`
+}
+
+// NewEthBridgeReleaseClaim is a constructor function for EthBridgeReleaseClaim
+func NewEthBridgeReleaseClaim(itemID string, ethereumTxHash string, ethereumLogIndex uint64, kind string, ethereumReceiver string, validator sdk.AccAddress, amount sdk.Coins) EthBridgeReleaseClaim {
+	return EthBridgeReleaseClaim{
+		ItemID:           itemID,
+		EthereumTxHash:   ethereumTxHash,
+		EthereumLogIndex: ethereumLogIndex,
+		Kind:             kind,
+		EthereumReceiver: ethereumReceiver,
+		Validator:        validator,
+		Amount:           amount,
+	}
+}
+
+// OracleReleaseClaim is the content of a release claim stored in the oracle, which validators must agree on
+type OracleReleaseClaim struct {
+	EthereumTxHash   string    `json:"ethereum_tx_hash"`
+	EthereumLogIndex uint64    `json:"ethereum_log_index"`
+	Kind             string    `json:"kind"`
+	EthereumReceiver string    `json:"ethereum_receiver"`
+	Amount           sdk.Coins `json:"amount"`
+}
+
+// CreateOracleClaimFromReleaseClaim converts an EthBridgeReleaseClaim into the prophecy id, validator and claim
+// content used by the oracle
+func CreateOracleClaimFromReleaseClaim(claim EthBridgeReleaseClaim) (string, sdk.ValAddress, string) {
+	claimContent := OracleReleaseClaim{
+		EthereumTxHash:   common.NormalizeEthHash(claim.EthereumTxHash),
+		EthereumLogIndex: claim.EthereumLogIndex,
+		Kind:             claim.Kind,
+		EthereumReceiver: claim.EthereumReceiver,
+		Amount:           claim.Amount,
+	}
+	claimBytes, _ := json.Marshal(claimContent)
+	return GetReleaseProphecyID(claim.ItemID), sdk.ValAddress(claim.Validator), string(claimBytes)
+}
+
+// CreateOracleReleaseClaimFromOracleString parses the final claim of a release prophecy
+func CreateOracleReleaseClaimFromOracleString(oracleClaimString string) (OracleReleaseClaim, sdk.Error) {
+	var oracleClaim OracleReleaseClaim
+	errRes := json.Unmarshal([]byte(oracleClaimString), &oracleClaim)
+	if errRes != nil {
+		return OracleReleaseClaim{}, sdk.ErrInternal(fmt.Sprintf("failed to parse release claim: %s", errRes))
+	}
+	return oracleClaim, nil
+}
+
+// Credit is what the mint of a peggy item left with its receiver, less the bridge fee and the coins its post-mint
+// actions moved on. It bounds the coins clawed back from the receiver when the item is withdrawn.
+type Credit struct {
+	Receiver sdk.AccAddress `json:"receiver"`
+	Amount   sdk.Coins      `json:"amount"`
+}
+
+// NewCredit returns a new Credit
+func NewCredit(receiver sdk.AccAddress, amount sdk.Coins) Credit {
+	return Credit{
+		Receiver: receiver,
+		Amount:   amount,
+	}
+}
+
+// Withdrawal records the release of a peggy item's locked funds on ethereum and how it was reconciled with the
+// coins claimed for it: coins that were still queued or delayed are frozen and never minted, and coins that were
+// already minted for a withdrawn item are clawed back from the receiver, up to what the item credited it and as far
+// as its balance allows. Whatever could not be clawed back is a deficit of the bridge.
+type Withdrawal struct {
+	ItemID           string    `json:"item_id"`
+	Kind             string    `json:"kind"`
+	EthereumTxHash   string    `json:"ethereum_tx_hash"`
+	EthereumReceiver string    `json:"ethereum_receiver"`
+	Amount           sdk.Coins `json:"amount"`
+	Height           int64     `json:"height"`
+	Frozen           sdk.Coins `json:"frozen"`
+	ClawedBack       sdk.Coins `json:"clawed_back"`
+	Deficit          sdk.Coins `json:"deficit"`
+}
+
+// NewWithdrawal returns a new Withdrawal that has not been reconciled yet
+func NewWithdrawal(itemID string, claim OracleReleaseClaim, height int64) Withdrawal {
+	return Withdrawal{
+		ItemID:           itemID,
+		Kind:             claim.Kind,
+		EthereumTxHash:   claim.EthereumTxHash,
+		EthereumReceiver: claim.EthereumReceiver,
+		Amount:           claim.Amount,
+		Height:           height,
+		Frozen:           sdk.NewCoins(),
+		ClawedBack:       sdk.NewCoins(),
+		Deficit:          sdk.NewCoins(),
+	}
+}
+
+func (w Withdrawal) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Item ID:     %s
+Kind:        %s
+Tx Hash:     %s
+Receiver:    %s
+Amount:      %s
+Height:      %d
+Frozen:      %s
+Clawed Back: %s
+Deficit:     %s`, w.ItemID, w.Kind, w.EthereumTxHash, w.EthereumReceiver, w.Amount, w.Height, w.Frozen, w.ClawedBack, w.Deficit))
+}
+
+// Withdrawals is a list of withdrawals
+type Withdrawals []Withdrawal
+
+func (ws Withdrawals) String() string {
+	out := make([]string, len(ws))
+	for i, w := range ws {
+		out[i] = w.String()
+	}
+	return strings.Join(out, "\n")
+}
+
+// DeficitReport is the total deficit of the bridge and the withdrawals it is owed for
+type DeficitReport struct {
+	Deficit     sdk.Coins   `json:"deficit"`
+	Withdrawals Withdrawals `json:"withdrawals"`
+}
+
+// NewDeficitReport builds the deficit report of a list of withdrawals
+func NewDeficitReport(withdrawals Withdrawals) DeficitReport {
+	report := DeficitReport{
+		Deficit:     sdk.NewCoins(),
+		Withdrawals: Withdrawals{},
+	}
+	for _, w := range withdrawals {
+		if w.Deficit.IsZero() {
+			continue
+		}
+		report.Deficit = report.Deficit.Add(w.Deficit)
+		report.Withdrawals = append(report.Withdrawals, w)
+	}
+	return report
+}
+
+func (report DeficitReport) String() string {
+	return strings.TrimSpace(fmt.Sprintf("Deficit: %s\n%s", report.Deficit, report.Withdrawals))
+}
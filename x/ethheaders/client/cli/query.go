@@ -0,0 +1,96 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
+	"github.com/spf13/cobra"
+)
+
+// GetCmdGetHeader queries a stored header by hash, or the best chain's header at a number
+func GetCmdGetHeader(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "header hash-or-number",
+		Short: "get a stored ethereum header by hash, or the best chain's header at a number",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			params := ethheaders.NewQueryHeaderParams(args[0], 0)
+			if !strings.HasPrefix(args[0], "0x") {
+				number, err := strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+				params = ethheaders.NewQueryHeaderParams("", number)
+			}
+
+			bz, err := cdc.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethheaders.QueryHeader)
+			res, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.Header
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetBestHeader queries the head of the best chain
+func GetCmdGetBestHeader(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "best",
+		Short: "get the head of the best ethereum chain",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethheaders.QueryBest)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.Header
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
+
+// GetCmdGetParams queries the params of the ethheaders module
+func GetCmdGetParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Short: "get the consensus rule, minimum difficulty and confirmations of the light client",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, ethheaders.QueryParams)
+			res, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				fmt.Printf(err.Error())
+				return nil
+			}
+
+			var out types.Params
+			cdc.MustUnmarshalJSON(res, &out)
+			return cliCtx.PrintOutput(out)
+		},
+	}
+}
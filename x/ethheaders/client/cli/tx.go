@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/utils"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
+	"github.com/spf13/cobra"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtxb "github.com/cosmos/cosmos-sdk/x/auth/client/txbuilder"
+)
+
+// GetCmdSubmitHeaders is the CLI command for submitting RLP encoded ethereum headers to the light client
+func GetCmdSubmitHeaders(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "submit-headers [header-rlp-hex]...",
+		Short: "submit hex encoded RLP ethereum headers, parents first",
+		Args:  cobra.RangeArgs(1, types.MaxHeadersPerMsg),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc).WithAccountDecoder(cdc)
+
+			txBldr := authtxb.NewTxBuilderFromCLI().WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			if err := cliCtx.EnsureAccountExists(); err != nil {
+				return err
+			}
+
+			headers := make([][]byte, len(args))
+			for i, arg := range args {
+				bz, err := hex.DecodeString(strings.TrimPrefix(arg, "0x"))
+				if err != nil {
+					return err
+				}
+				headers[i] = bz
+			}
+
+			msg := types.NewMsgSubmitHeaders(cliCtx.GetFromAddress(), headers)
+			err := msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+
+			cliCtx.PrintResponse = true
+
+			return utils.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+}
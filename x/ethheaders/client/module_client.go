@@ -0,0 +1,49 @@
+package client
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	ethheaderscmd "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/client/cli"
+	"github.com/spf13/cobra"
+	amino "github.com/tendermint/go-amino"
+)
+
+// ModuleClient exports all client functionality from this module
+type ModuleClient struct {
+	queryRoute string
+	cdc        *amino.Codec
+}
+
+func NewModuleClient(queryRoute string, cdc *amino.Codec) ModuleClient {
+	return ModuleClient{queryRoute, cdc}
+}
+
+// GetQueryCmd returns the cli query commands for this module
+func (mc ModuleClient) GetQueryCmd() *cobra.Command {
+	// Group ethheaders queries under a subcommand
+	ethHeadersQueryCmd := &cobra.Command{
+		Use:   "ethheaders",
+		Short: "Querying commands for the ethheaders module",
+	}
+
+	ethHeadersQueryCmd.AddCommand(client.GetCommands(
+		ethheaderscmd.GetCmdGetHeader(mc.queryRoute, mc.cdc),
+		ethheaderscmd.GetCmdGetBestHeader(mc.queryRoute, mc.cdc),
+		ethheaderscmd.GetCmdGetParams(mc.queryRoute, mc.cdc),
+	)...)
+
+	return ethHeadersQueryCmd
+}
+
+// GetTxCmd returns the transaction commands for this module
+func (mc ModuleClient) GetTxCmd() *cobra.Command {
+	ethHeadersTxCmd := &cobra.Command{
+		Use:   "ethheaders",
+		Short: "EthHeaders transactions subcommands",
+	}
+
+	ethHeadersTxCmd.AddCommand(client.PostCommands(
+		ethheaderscmd.GetCmdSubmitHeaders(mc.cdc),
+	)...)
+
+	return ethHeadersTxCmd
+}
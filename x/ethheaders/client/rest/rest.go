@@ -0,0 +1,70 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/querier"
+)
+
+const (
+	restHash   = "hash"
+	restNumber = "number"
+)
+
+// RegisterRoutes - Central function to define routes that get registered by the main application
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router, cdc *codec.Codec, queryRoute string) {
+	r.HandleFunc(fmt.Sprintf("/%s/params", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryParams)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/best", queryRoute), getHandler(cdc, cliCtx, queryRoute, querier.QueryBest)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/headers/{%s:0x[0-9a-fA-F]+}", queryRoute, restHash), getHeaderHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+	r.HandleFunc(fmt.Sprintf("/%s/headers/{%s:[0-9]+}", queryRoute, restNumber), getHeaderHandler(cdc, cliCtx, queryRoute)).Methods("GET")
+}
+
+func getHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string, endpoint string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, endpoint)
+		res, err := cliCtx.QueryWithData(route, nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
+
+func getHeaderHandler(cdc *codec.Codec, cliCtx context.CLIContext, queryRoute string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+		var number uint64
+		if vars[restNumber] != "" {
+			var err error
+			number, err = strconv.ParseUint(vars[restNumber], 10, 64)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		}
+
+		bz, err := cdc.MarshalJSON(ethheaders.NewQueryHeaderParams(vars[restHash], number))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		route := fmt.Sprintf("custom/%s/%s", queryRoute, querier.QueryHeader)
+		res, err := cliCtx.QueryWithData(route, bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+
+		rest.PostProcessResponse(w, cdc, res, cliCtx.Indent)
+	}
+}
@@ -0,0 +1,67 @@
+package ethheaders
+
+import (
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/keeper"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/querier"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
+)
+
+type (
+	Keeper = keeper.Keeper
+
+	MsgSubmitHeaders = types.MsgSubmitHeaders
+
+	Header        = types.Header
+	Headers       = types.Headers
+	ReceiptProof  = types.ReceiptProof
+	ProvenReceipt = types.ProvenReceipt
+	Params        = types.Params
+	GenesisState  = types.GenesisState
+)
+
+var (
+	NewKeeper = keeper.NewKeeper
+
+	NewMsgSubmitHeaders = types.NewMsgSubmitHeaders
+	NewHeader           = types.NewHeader
+	DecodeHeader        = types.DecodeHeader
+	NewReceiptProof     = types.NewReceiptProof
+
+	NewParams           = types.NewParams
+	DefaultParams       = types.DefaultParams
+	NewGenesisState     = types.NewGenesisState
+	DefaultGenesisState = types.DefaultGenesisState
+	ValidateGenesis     = types.ValidateGenesis
+
+	NewQueryHeaderParams = types.NewQueryHeaderParams
+
+	ErrInvalidHeader       = types.ErrInvalidHeader
+	ErrUnknownParent       = types.ErrUnknownParent
+	ErrInvalidDifficulty   = types.ErrInvalidDifficulty
+	ErrHeaderNotFound      = types.ErrHeaderNotFound
+	ErrHeaderNotConfirmed  = types.ErrHeaderNotConfirmed
+	ErrInvalidReceiptProof = types.ErrInvalidReceiptProof
+	ErrUnauthorized        = types.ErrUnauthorized
+
+	RegisterCodec = types.RegisterCodec
+
+	NewQuerier = querier.NewQuerier
+)
+
+const (
+	StoreKey         = types.StoreKey
+	QuerierRoute     = types.QuerierRoute
+	RouterKey        = types.RouterKey
+	DefaultCodespace = types.DefaultCodespace
+	ModuleName       = types.ModuleName
+
+	DefaultParamspace = types.DefaultParamspace
+
+	ConsensusRuleProofOfWork  = types.ConsensusRuleProofOfWork
+	ConsensusRuleProofOfStake = types.ConsensusRuleProofOfStake
+	MaxHeadersPerMsg          = types.MaxHeadersPerMsg
+
+	QueryParams = querier.QueryParams
+	QueryHeader = querier.QueryHeader
+	QueryBest   = querier.QueryBest
+)
@@ -0,0 +1,17 @@
+package ethheaders
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis sets the ethheaders params and imports the trusted headers of the genesis state
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+	keeper.ImportHeaders(ctx, data.Headers)
+}
+
+// ExportGenesis returns the ethheaders state as a genesis state. Only the best chain is exported: headers that
+// are not approved yet have to be submitted again, as the oracle does not export its prophecies.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) GenesisState {
+	return NewGenesisState(keeper.GetParams(ctx), keeper.GetBestChain(ctx))
+}
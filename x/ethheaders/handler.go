@@ -0,0 +1,37 @@
+package ethheaders
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
+)
+
+// NewHandler returns a handler for "ethheaders" type messages.
+func NewHandler(keeper Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		switch msg := msg.(type) {
+		case MsgSubmitHeaders:
+			return handleMsgSubmitHeaders(ctx, keeper, msg)
+		default:
+			errMsg := fmt.Sprintf("Unrecognized ethheaders message type: %v", msg.Type())
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+// Handle a message to submit ethereum headers. The headers are added in order, so a header can be the child of the
+// one before it, and the message fails as a whole if any of them is invalid.
+func handleMsgSubmitHeaders(ctx sdk.Context, keeper Keeper, msg MsgSubmitHeaders) sdk.Result {
+	for _, bz := range msg.Headers {
+		header, err := types.DecodeHeader(bz)
+		if err != nil {
+			return types.ErrInvalidHeader(keeper.Codespace(), err.Error()).Result()
+		}
+		if err := keeper.AddHeader(ctx, msg.Submitter, header); err != nil {
+			return err.Result()
+		}
+	}
+	best, _ := keeper.GetBestHeader(ctx)
+	return sdk.Result{Log: fmt.Sprintf("best: %d %s", best.Number, best.Hash)}
+}
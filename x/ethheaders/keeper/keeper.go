@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle"
+	oracletypes "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Keeper maintains the link to data storage and exposes getter/setter methods for the ethereum headers
+type Keeper struct {
+	oracleKeeper  oracle.Keeper
+	stakingKeeper staking.Keeper
+
+	storeKey   sdk.StoreKey // Unexposed key to access store from sdk.Context
+	paramSpace params.Subspace
+
+	cdc *codec.Codec // The wire codec for binary encoding/decoding.
+
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper creates new instances of the ethheaders Keeper
+func NewKeeper(oracleKeeper oracle.Keeper, stakingKeeper staking.Keeper, storeKey sdk.StoreKey,
+	paramSpace params.Subspace, cdc *codec.Codec, codespace sdk.CodespaceType) Keeper {
+	return Keeper{
+		oracleKeeper:  oracleKeeper,
+		stakingKeeper: stakingKeeper,
+		storeKey:      storeKey,
+		paramSpace:    paramSpace.WithKeyTable(types.ParamKeyTable()),
+		cdc:           cdc,
+		codespace:     codespace,
+	}
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// GetParams returns the current params of the ethheaders module
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the params of the ethheaders module
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// AddHeader validates an ethereum header against its stored parent and the consensus rule and stores it. Neither
+// rule proves a header was really mined or proposed on ethereum, so a bonded validator submitting a header also
+// claims it through the oracle, and the header is only approved, and can become the head of the best chain, once
+// those claims reach consensus. Headers that are already stored only count the submitter's claim.
+func (k Keeper) AddHeader(ctx sdk.Context, submitter sdk.AccAddress, header *ethtypes.Header) sdk.Error {
+	if stored, found := k.GetHeader(ctx, header.Hash().Hex()); found {
+		return k.claimHeader(ctx, submitter, stored)
+	}
+	parent, found := k.GetHeader(ctx, header.ParentHash.Hex())
+	if !found {
+		return types.ErrUnknownParent(k.Codespace())
+	}
+	if header.Number.Uint64() != parent.Number+1 {
+		return types.ErrInvalidHeader(k.Codespace(), fmt.Sprintf("number %d does not follow its parent's %d",
+			header.Number.Uint64(), parent.Number))
+	}
+	if header.Time <= parent.Time {
+		return types.ErrInvalidHeader(k.Codespace(), "time must be after its parent's")
+	}
+
+	difficulty := sdk.NewIntFromBigInt(header.Difficulty)
+	params := k.GetParams(ctx)
+	switch params.ConsensusRule {
+	case types.ConsensusRuleProofOfWork:
+		if err := k.validateDifficulty(difficulty, parent.Difficulty, params.MinimumDifficulty); err != nil {
+			return err
+		}
+	case types.ConsensusRuleProofOfStake:
+		if !difficulty.IsZero() {
+			return types.ErrInvalidDifficulty(k.Codespace(), "proof of stake headers have no difficulty")
+		}
+		if !k.isBondedValidator(ctx, sdk.ValAddress(submitter)) {
+			return types.ErrUnauthorized(k.Codespace())
+		}
+	}
+
+	stored := types.NewHeader(header, parent.TotalDifficulty.Add(difficulty))
+	k.SetHeader(ctx, stored)
+	return k.claimHeader(ctx, submitter, stored)
+}
+
+// claimHeader adds the claim of a bonded validator to the prophecy that approves a header, and approves the header
+// once the prophecy succeeds. Submissions by other accounts and repeated claims are ignored.
+func (k Keeper) claimHeader(ctx sdk.Context, submitter sdk.AccAddress, header types.Header) sdk.Error {
+	validator := sdk.ValAddress(submitter)
+	if header.Approved || !k.isBondedValidator(ctx, validator) {
+		return nil
+	}
+	status, err := k.oracleKeeper.ProcessClaim(ctx, types.GetProphecyID(header.Hash), validator, header.Hash)
+	if err != nil {
+		if err.Code() == oracletypes.CodeDuplicateMessage {
+			return nil
+		}
+		return err
+	}
+	if status.StatusText == oracle.SuccessStatus {
+		k.approveHeader(ctx, header)
+	}
+	return nil
+}
+
+// approveHeader marks a header approved and moves the best chain to it when its chain is preferred
+func (k Keeper) approveHeader(ctx sdk.Context, header types.Header) {
+	header.Approved = true
+	k.SetHeader(ctx, header)
+	if best, found := k.GetBestHeader(ctx); !found || header.IsBetterThan(best) {
+		k.setBestHeader(ctx, header)
+	}
+}
+
+func (k Keeper) isBondedValidator(ctx sdk.Context, address sdk.ValAddress) bool {
+	validator, found := k.stakingKeeper.GetValidator(ctx, address)
+	return found && validator.GetStatus() == sdk.Bonded
+}
+
+// validateDifficulty checks that a proof of work difficulty is above the minimum and within the homestead
+// adjustment bounds of its parent's: it can rise by at most parent / 2048 and fall by at most 99 times that
+func (k Keeper) validateDifficulty(difficulty sdk.Int, parentDifficulty sdk.Int, minimum sdk.Int) sdk.Error {
+	if difficulty.LT(minimum) || !difficulty.IsPositive() {
+		return types.ErrInvalidDifficulty(k.Codespace(), fmt.Sprintf("%s is below the minimum %s", difficulty, minimum))
+	}
+	step := parentDifficulty.QuoRaw(types.DifficultyBoundDivisor)
+	if difficulty.GT(parentDifficulty.Add(step)) || difficulty.LT(parentDifficulty.Sub(step.MulRaw(99))) {
+		return types.ErrInvalidDifficulty(k.Codespace(), fmt.Sprintf("%s is out of bounds of its parent's %s",
+			difficulty, parentDifficulty))
+	}
+	return nil
+}
+
+// GetHeader returns the stored header with a hash
+func (k Keeper) GetHeader(ctx sdk.Context, hash string) (types.Header, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetHeaderKey(hash))
+	if bz == nil {
+		return types.Header{}, false
+	}
+	var header types.Header
+	k.cdc.MustUnmarshalBinaryBare(bz, &header)
+	return header, true
+}
+
+// SetHeader stores a header
+func (k Keeper) SetHeader(ctx sdk.Context, header types.Header) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetHeaderKey(header.Hash), k.cdc.MustMarshalBinaryBare(header))
+}
+
+// GetHeaders returns every stored header
+func (k Keeper) GetHeaders(ctx sdk.Context) types.Headers {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.HeaderPrefix)
+	defer iterator.Close()
+
+	headers := types.Headers{}
+	for ; iterator.Valid(); iterator.Next() {
+		var header types.Header
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &header)
+		headers = append(headers, header)
+	}
+	return headers
+}
+
+// GetBestHeader returns the head of the best chain
+func (k Keeper) GetBestHeader(ctx sdk.Context) (types.Header, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BestHeaderKey)
+	if bz == nil {
+		return types.Header{}, false
+	}
+	return k.GetHeader(ctx, string(bz))
+}
+
+// GetCanonicalHeader returns the header of the best chain at a number
+func (k Keeper) GetCanonicalHeader(ctx sdk.Context, number uint64) (types.Header, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetCanonicalKey(number))
+	if bz == nil {
+		return types.Header{}, false
+	}
+	return k.GetHeader(ctx, string(bz))
+}
+
+// setBestHeader makes a header the head of the best chain and indexes its ancestors by number, until they join
+// the previous best chain
+func (k Keeper) setBestHeader(ctx sdk.Context, header types.Header) {
+	store := ctx.KVStore(k.storeKey)
+	if best, found := k.GetBestHeader(ctx); found {
+		for number := header.Number + 1; number <= best.Number; number++ {
+			store.Delete(types.GetCanonicalKey(number))
+		}
+	}
+	store.Set(types.BestHeaderKey, []byte(header.Hash))
+
+	for found := true; found; header, found = k.GetHeader(ctx, header.ParentHash) {
+		if canonical, ok := k.GetCanonicalHeader(ctx, header.Number); ok && canonical.Hash == header.Hash {
+			return
+		}
+		store.Set(types.GetCanonicalKey(header.Number), []byte(header.Hash))
+	}
+}
+
+// GetConfirmedHeader returns a stored header that is on the best chain with at least the confirmations required
+// by the params built on it. The head of the best chain is always approved, so its ancestors are vouched for too.
+func (k Keeper) GetConfirmedHeader(ctx sdk.Context, hash string) (types.Header, sdk.Error) {
+	header, found := k.GetHeader(ctx, hash)
+	if !found {
+		return types.Header{}, types.ErrHeaderNotFound(k.Codespace())
+	}
+	canonical, found := k.GetCanonicalHeader(ctx, header.Number)
+	best, _ := k.GetBestHeader(ctx)
+	if !found || canonical.Hash != header.Hash || best.Number-header.Number < k.GetParams(ctx).Confirmations {
+		return types.Header{}, types.ErrHeaderNotConfirmed(k.Codespace())
+	}
+	return header, nil
+}
+
+// VerifyReceiptProof checks a receipt proof against a confirmed header and returns the proven receipt
+func (k Keeper) VerifyReceiptProof(ctx sdk.Context, proof types.ReceiptProof) (types.ProvenReceipt, sdk.Error) {
+	header, err := k.GetConfirmedHeader(ctx, proof.BlockHash)
+	if err != nil {
+		return types.ProvenReceipt{}, err
+	}
+	proven, proofErr := proof.Verify(header.ReceiptsRoot, header.TransactionsRoot)
+	if proofErr != nil {
+		return types.ProvenReceipt{}, types.ErrInvalidReceiptProof(k.Codespace(), proofErr.Error())
+	}
+	return proven, nil
+}
+
+// GetBestChain returns the headers of the best chain, from the oldest stored one to its head
+func (k Keeper) GetBestChain(ctx sdk.Context) types.Headers {
+	headers := types.Headers{}
+	header, found := k.GetBestHeader(ctx)
+	for ; found; header, found = k.GetHeader(ctx, header.ParentHash) {
+		headers = append(types.Headers{header}, headers...)
+	}
+	return headers
+}
+
+// ImportHeaders stores trusted headers as approved and makes the best of them the head of the best chain
+func (k Keeper) ImportHeaders(ctx sdk.Context, headers types.Headers) {
+	for _, header := range headers {
+		k.approveHeader(ctx, header)
+	}
+}
@@ -0,0 +1,202 @@
+package keeper
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
+)
+
+func TestAddProofOfWorkHeaders(t *testing.T) {
+	params := types.NewParams(types.ConsensusRuleProofOfWork, sdk.NewInt(1000), 2)
+	ctx, keeper, validatorAddresses := CreateTestKeeper(t, params, []int64{3, 7})
+	submitter := sdk.AccAddress([]byte("submitter"))
+	majority := sdk.AccAddress(validatorAddresses[1])
+
+	anchor := types.CreateTestAnchorHeader(100000, gethCommon.Hash{})
+	keeper.ImportHeaders(ctx, types.Headers{types.NewHeader(anchor, sdk.NewIntFromBigInt(anchor.Difficulty))})
+
+	//Anyone can store headers, but they only become the best chain once validators with consensus power submit them
+	chain := types.CreateTestHeaders(anchor, 3, 100000, gethCommon.Hash{}, 0)
+	for _, header := range chain {
+		require.NoError(t, keeper.AddHeader(ctx, submitter, header))
+	}
+	best, found := keeper.GetBestHeader(ctx)
+	require.True(t, found)
+	require.Equal(t, anchor.Hash().Hex(), best.Hash)
+	for _, header := range chain {
+		require.NoError(t, keeper.AddHeader(ctx, majority, header))
+	}
+	best, _ = keeper.GetBestHeader(ctx)
+	require.Equal(t, chain[2].Hash().Hex(), best.Hash)
+	require.Equal(t, sdk.NewInt(400000), best.TotalDifficulty)
+	require.True(t, best.Approved)
+
+	//Resubmitting a known header is a no-op, a header without a stored parent is rejected
+	require.NoError(t, keeper.AddHeader(ctx, submitter, chain[1]))
+	require.NoError(t, keeper.AddHeader(ctx, majority, chain[1]))
+	orphan := types.CreateTestHeaders(chain[2], 2, 100000, gethCommon.Hash{}, 0)[1]
+	require.Equal(t, types.CodeUnknownParent, keeper.AddHeader(ctx, submitter, orphan).Code())
+
+	//The difficulty must stay within the adjustment bounds of the parent's and above the minimum
+	tooHard := types.CreateTestHeaders(anchor, 1, 100000+100000/2048+1, gethCommon.Hash{}, 1)[0]
+	require.Equal(t, types.CodeInvalidDifficulty, keeper.AddHeader(ctx, submitter, tooHard).Code())
+	tooEasy := types.CreateTestHeaders(chain[2], 1, 100000-(100000/2048)*99-1, gethCommon.Hash{}, 1)[0]
+	require.Equal(t, types.CodeInvalidDifficulty, keeper.AddHeader(ctx, submitter, tooEasy).Code())
+	weakParent := types.CreateTestAnchorHeader(1000, gethCommon.Hash{})
+	weakParent.Number = big.NewInt(200)
+	keeper.ImportHeaders(ctx, types.Headers{types.NewHeader(weakParent, sdk.ZeroInt())})
+	belowMinimum := types.CreateTestHeaders(weakParent, 1, 999, gethCommon.Hash{}, 0)[0]
+	require.Equal(t, types.CodeInvalidDifficulty, keeper.AddHeader(ctx, submitter, belowMinimum).Code())
+
+	//An approved fork with more total difficulty becomes the best chain
+	fork := types.CreateTestHeaders(anchor, 3, 100048, gethCommon.Hash{}, 2)
+	for _, header := range fork {
+		require.NoError(t, keeper.AddHeader(ctx, majority, header))
+	}
+	best, _ = keeper.GetBestHeader(ctx)
+	require.Equal(t, fork[2].Hash().Hex(), best.Hash)
+	canonical, found := keeper.GetCanonicalHeader(ctx, 101)
+	require.True(t, found)
+	require.Equal(t, fork[0].Hash().Hex(), canonical.Hash)
+
+	//Only headers of the best chain with enough headers built on them are confirmed
+	_, err := keeper.GetConfirmedHeader(ctx, fork[0].Hash().Hex())
+	require.NoError(t, err)
+	_, err = keeper.GetConfirmedHeader(ctx, fork[1].Hash().Hex())
+	require.Equal(t, types.CodeHeaderNotConfirmed, err.Code())
+	_, err = keeper.GetConfirmedHeader(ctx, chain[0].Hash().Hex())
+	require.Equal(t, types.CodeHeaderNotConfirmed, err.Code())
+	_, err = keeper.GetConfirmedHeader(ctx, orphan.Hash().Hex())
+	require.Equal(t, types.CodeHeaderNotFound, err.Code())
+}
+
+func TestAddProofOfStakeHeaders(t *testing.T) {
+	params := types.NewParams(types.ConsensusRuleProofOfStake, sdk.ZeroInt(), 0)
+	ctx, keeper, validatorAddresses := CreateTestKeeper(t, params, []int64{3, 7})
+
+	anchor := types.CreateTestAnchorHeader(0, gethCommon.Hash{})
+	keeper.ImportHeaders(ctx, types.Headers{types.NewHeader(anchor, sdk.ZeroInt())})
+	chain := types.CreateTestHeaders(anchor, 2, 0, gethCommon.Hash{}, 0)
+
+	//Only bonded validators submit proof of stake headers, which have no difficulty
+	err := keeper.AddHeader(ctx, sdk.AccAddress([]byte("submitter")), chain[0])
+	require.Equal(t, types.CodeUnauthorized, err.Code())
+	require.NoError(t, keeper.AddHeader(ctx, sdk.AccAddress(validatorAddresses[0]), chain[0]))
+	withDifficulty := types.CreateTestHeaders(chain[0], 1, 1, gethCommon.Hash{}, 1)[0]
+	err = keeper.AddHeader(ctx, sdk.AccAddress(validatorAddresses[1]), withDifficulty)
+	require.Equal(t, types.CodeInvalidDifficulty, err.Code())
+
+	//Proof of stake chains are ordered by length
+	require.NoError(t, keeper.AddHeader(ctx, sdk.AccAddress(validatorAddresses[1]), chain[1]))
+	best, _ := keeper.GetBestHeader(ctx)
+	require.Equal(t, uint64(102), best.Number)
+
+	//Headers must be later than their parent
+	late := types.CreateTestHeaders(chain[1], 1, 0, gethCommon.Hash{}, 0)[0]
+	late.Time = chain[1].Time
+	err = keeper.AddHeader(ctx, sdk.AccAddress(validatorAddresses[0]), late)
+	require.Equal(t, types.CodeInvalidHeader, err.Code())
+}
+
+func TestForgedBranchRejected(t *testing.T) {
+	params := types.NewParams(types.ConsensusRuleProofOfWork, sdk.NewInt(1000), 1)
+	ctx, keeper, validatorAddresses := CreateTestKeeper(t, params, []int64{3, 7})
+	minority := sdk.AccAddress(validatorAddresses[0])
+	majority := sdk.AccAddress(validatorAddresses[1])
+
+	anchor := types.CreateTestAnchorHeader(100000, gethCommon.Hash{})
+	keeper.ImportHeaders(ctx, types.Headers{types.NewHeader(anchor, sdk.NewIntFromBigInt(anchor.Difficulty))})
+	chain := types.CreateTestHeaders(anchor, 2, 100000, gethCommon.Hash{}, 0)
+	for _, header := range chain {
+		require.NoError(t, keeper.AddHeader(ctx, majority, header))
+	}
+
+	//A heavier branch with valid difficulties but no real seal is stored, yet the minority cannot approve it
+	forged := types.CreateTestHeaders(anchor, 3, 100048, gethCommon.Hash{}, 1)
+	for _, header := range forged {
+		require.NoError(t, keeper.AddHeader(ctx, sdk.AccAddress([]byte("forger")), header))
+		require.NoError(t, keeper.AddHeader(ctx, minority, header))
+	}
+	best, _ := keeper.GetBestHeader(ctx)
+	require.Equal(t, chain[1].Hash().Hex(), best.Hash)
+	_, err := keeper.GetConfirmedHeader(ctx, forged[0].Hash().Hex())
+	require.Equal(t, types.CodeHeaderNotConfirmed, err.Code())
+	_, err = keeper.GetConfirmedHeader(ctx, chain[0].Hash().Hex())
+	require.NoError(t, err)
+
+	//The same holds for a single validator extending a fake proof of stake chain
+	keeper.SetParams(ctx, types.NewParams(types.ConsensusRuleProofOfStake, sdk.ZeroInt(), 1))
+	fake := types.CreateTestHeaders(chain[1], 3, 0, gethCommon.Hash{}, 1)
+	for _, header := range fake {
+		require.NoError(t, keeper.AddHeader(ctx, minority, header))
+	}
+	best, _ = keeper.GetBestHeader(ctx)
+	require.Equal(t, chain[1].Hash().Hex(), best.Hash)
+	_, err = keeper.GetConfirmedHeader(ctx, fake[0].Hash().Hex())
+	require.Equal(t, types.CodeHeaderNotConfirmed, err.Code())
+
+	//Only the approved best chain is exported
+	require.Len(t, keeper.GetBestChain(ctx), 3)
+}
+
+func TestVerifyReceiptProof(t *testing.T) {
+	params := types.NewParams(types.ConsensusRuleProofOfStake, sdk.ZeroInt(), 1)
+	ctx, keeper, validatorAddresses := CreateTestKeeper(t, params, []int64{3, 7})
+
+	receipts := ethtypes.Receipts{
+		{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 21000,
+			Logs: []*ethtypes.Log{{Address: gethCommon.HexToAddress("0x02")}}},
+		{Type: ethtypes.DynamicFeeTxType, Status: ethtypes.ReceiptStatusSuccessful, CumulativeGasUsed: 90000,
+			Logs: []*ethtypes.Log{{Address: gethCommon.HexToAddress("0x01"), Data: []byte("locked")}}},
+		{Type: ethtypes.LegacyTxType, Status: ethtypes.ReceiptStatusFailed, CumulativeGasUsed: 120000},
+	}
+	root, nodes := types.CreateTestReceiptProof(t, receipts, 1)
+	require.Equal(t, ethtypes.DeriveSha(receipts, trie.NewStackTrie(nil)), root)
+	transactions := ethtypes.Transactions{
+		ethtypes.NewTransaction(0, gethCommon.HexToAddress("0x03"), big.NewInt(1), 21000, big.NewInt(1), nil),
+		ethtypes.NewTransaction(1, gethCommon.HexToAddress("0x03"), big.NewInt(2), 69000, big.NewInt(1), nil),
+		ethtypes.NewTransaction(2, gethCommon.HexToAddress("0x03"), big.NewInt(3), 30000, big.NewInt(1), nil),
+	}
+	txRoot, txNodes := types.CreateTestTransactionProof(t, transactions, 1)
+
+	anchor := types.CreateTestAnchorHeader(0, root)
+	anchor.TxHash = txRoot
+	keeper.ImportHeaders(ctx, types.Headers{types.NewHeader(anchor, sdk.ZeroInt())})
+
+	//The header of the receipt must be confirmed
+	proof := types.NewReceiptProof(anchor.Hash().Hex(), 1, nodes, txNodes)
+	_, err := keeper.VerifyReceiptProof(ctx, proof)
+	require.Equal(t, types.CodeHeaderNotConfirmed, err.Code())
+	next := types.CreateTestHeaders(anchor, 1, 0, gethCommon.Hash{}, 0)[0]
+	require.NoError(t, keeper.AddHeader(ctx, sdk.AccAddress(validatorAddresses[1]), next))
+
+	proven, err := keeper.VerifyReceiptProof(ctx, proof)
+	require.NoError(t, err)
+	require.Equal(t, uint64(90000), proven.Receipt.CumulativeGasUsed)
+	require.Len(t, proven.Receipt.Logs, 1)
+	require.Equal(t, []byte("locked"), proven.Receipt.Logs[0].Data)
+	require.Equal(t, transactions[1].Hash(), proven.TxHash)
+	require.Equal(t, uint64(1), proven.LogOffset)
+
+	//The proof only proves the receipt at its index, and only against its own block
+	_, err = keeper.VerifyReceiptProof(ctx, types.NewReceiptProof(anchor.Hash().Hex(), 2, nodes, txNodes))
+	require.Equal(t, types.CodeInvalidReceiptProof, err.Code())
+	_, err = keeper.VerifyReceiptProof(ctx, types.NewReceiptProof(anchor.Hash().Hex(), 1, nodes[1:], txNodes))
+	require.Equal(t, types.CodeInvalidReceiptProof, err.Code())
+	otherRoot, otherNodes := types.CreateTestReceiptProof(t, receipts[:2], 1)
+	require.NotEqual(t, root, otherRoot)
+	_, err = keeper.VerifyReceiptProof(ctx, types.NewReceiptProof(anchor.Hash().Hex(), 1, otherNodes, txNodes))
+	require.Equal(t, types.CodeInvalidReceiptProof, err.Code())
+
+	//The transaction must be proven at the index of the receipt
+	_, otherTxNodes := types.CreateTestTransactionProof(t, transactions, 2)
+	_, err = keeper.VerifyReceiptProof(ctx, types.NewReceiptProof(anchor.Hash().Hex(), 1, nodes, otherTxNodes))
+	require.Equal(t, types.CodeInvalidReceiptProof, err.Code())
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	stakingKeeperLib "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
+	oracleKeeperLib "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/keeper"
+	oracletypes "github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tendermint/libs/db"
+)
+
+// CreateTestKeeper creates an ethheaders Keeper and Context with the given params, backed by an oracle with bonded
+// validators of the given powers
+func CreateTestKeeper(t *testing.T, headerParams types.Params, validatorPowers []int64) (sdk.Context, Keeper, []sdk.ValAddress) {
+	keyEthHeaders := sdk.NewKVStoreKey(types.StoreKey)
+	keyOracle := sdk.NewKVStoreKey(oracletypes.StoreKey)
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
+	tkeyStaking := sdk.NewTransientStoreKey(staking.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(tkeyStaking, sdk.StoreTypeTransient, nil)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyEthHeaders, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyOracle, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	err := ms.LoadLatestVersion()
+	require.Nil(t, err)
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "testchainid"}, false, log.NewNopLogger())
+	cdc := oracleKeeperLib.MakeTestCodec()
+
+	pk := params.NewKeeper(cdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, pk.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, pk.Subspace(bank.DefaultParamspace), bank.DefaultCodespace)
+
+	stakingKeeper := staking.NewKeeper(cdc, keyStaking, tkeyStaking, bankKeeper, pk.Subspace(staking.DefaultParamspace), staking.DefaultCodespace)
+	stakingKeeper.SetPool(ctx, staking.InitialPool())
+	stakingKeeper.SetParams(ctx, staking.DefaultParams())
+
+	oracleKeeper, err := oracleKeeperLib.NewKeeper(stakingKeeper, keyOracle, cdc, oracletypes.DefaultCodespace, 0.7)
+	require.Nil(t, err)
+
+	keeper := NewKeeper(oracleKeeper, stakingKeeper, keyEthHeaders, pk.Subspace(types.DefaultParamspace), cdc, types.DefaultCodespace)
+	keeper.SetParams(ctx, headerParams)
+
+	_, valAddresses := oracleKeeperLib.CreateTestAddrs(len(validatorPowers))
+	publicKeys := oracleKeeperLib.CreateTestPubKeys(len(validatorPowers))
+	pool := stakingKeeper.GetPool(ctx)
+	for i, power := range validatorPowers {
+		tokens := sdk.TokensFromTendermintPower(power)
+		pool.NotBondedTokens = pool.NotBondedTokens.Add(tokens)
+		validator := staking.NewValidator(valAddresses[i], publicKeys[i], staking.Description{})
+		validator.Status = sdk.Bonded
+		validator.Tokens = sdk.ZeroInt()
+		validator, pool, _ = validator.AddTokensFromDel(pool, tokens)
+		stakingKeeper.SetPool(ctx, pool)
+		stakingKeeperLib.TestingUpdateValidator(stakingKeeper, ctx, validator, true)
+	}
+
+	return ctx, keeper, valAddresses
+}
@@ -0,0 +1,68 @@
+package querier
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/keeper"
+	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/ethheaders/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// query endpoints supported by the ethheaders Querier
+const (
+	QueryParams = "params"
+	QueryHeader = "header"
+	QueryBest   = "best"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(keeper keeper.Keeper, cdc *codec.Codec) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err sdk.Error) {
+		switch path[0] {
+		case QueryParams:
+			return marshalResponse(cdc, keeper.GetParams(ctx))
+		case QueryHeader:
+			return queryHeader(ctx, cdc, req, keeper)
+		case QueryBest:
+			best, found := keeper.GetBestHeader(ctx)
+			if !found {
+				return []byte{}, types.ErrHeaderNotFound(keeper.Codespace())
+			}
+			return marshalResponse(cdc, best)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown ethheaders query endpoint")
+		}
+	}
+}
+
+func queryHeader(ctx sdk.Context, cdc *codec.Codec, req abci.RequestQuery, keeper keeper.Keeper) (res []byte, err sdk.Error) {
+	var params types.QueryHeaderParams
+
+	if errRes := cdc.UnmarshalJSON(req.Data, &params); errRes != nil {
+		return []byte{}, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", errRes))
+	}
+
+	var header types.Header
+	var found bool
+	if params.Hash != "" {
+		header, found = keeper.GetHeader(ctx, params.Hash)
+	} else {
+		header, found = keeper.GetCanonicalHeader(ctx, params.Number)
+	}
+	if !found {
+		return []byte{}, types.ErrHeaderNotFound(keeper.Codespace())
+	}
+
+	return marshalResponse(cdc, header)
+}
+
+func marshalResponse(cdc *codec.Codec, response interface{}) (res []byte, err sdk.Error) {
+	bz, err2 := codec.MarshalJSONIndent(cdc, response)
+	if err2 != nil {
+		panic(fmt.Sprintf("could not marshal result to JSON: %s", err2))
+	}
+
+	return bz, nil
+}
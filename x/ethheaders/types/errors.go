@@ -0,0 +1,49 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Local code type
+type CodeType = sdk.CodeType
+
+// Exported code type numbers
+const (
+	DefaultCodespace sdk.CodespaceType = ModuleName
+
+	CodeInvalidHeader       CodeType = 1
+	CodeUnknownParent       CodeType = 2
+	CodeInvalidDifficulty   CodeType = 3
+	CodeHeaderNotFound      CodeType = 4
+	CodeHeaderNotConfirmed  CodeType = 5
+	CodeInvalidReceiptProof CodeType = 6
+	CodeUnauthorized        CodeType = 7
+)
+
+func ErrInvalidHeader(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidHeader, "invalid ethereum header: "+reason)
+}
+
+func ErrUnknownParent(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnknownParent, "the parent of the ethereum header is not stored")
+}
+
+func ErrInvalidDifficulty(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidDifficulty, "invalid ethereum header difficulty: "+reason)
+}
+
+func ErrHeaderNotFound(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeHeaderNotFound, "no ethereum header found for this hash")
+}
+
+func ErrHeaderNotConfirmed(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeHeaderNotConfirmed, "ethereum header is not confirmed on the best chain")
+}
+
+func ErrInvalidReceiptProof(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidReceiptProof, "invalid receipt proof: "+reason)
+}
+
+func ErrUnauthorized(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorized, "only bonded validators can submit proof of stake headers")
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+)
+
+// GenesisState is the state of the ethheaders module at genesis. Its headers are trusted as they are, so they
+// anchor the light client: submitted headers must descend from them.
+type GenesisState struct {
+	Params  Params  `json:"params"`
+	Headers Headers `json:"headers"`
+}
+
+// NewGenesisState creates a new GenesisState
+func NewGenesisState(params Params, headers Headers) GenesisState {
+	return GenesisState{
+		Params:  params,
+		Headers: headers,
+	}
+}
+
+// DefaultGenesisState returns a genesis state with the default params and no headers
+func DefaultGenesisState() GenesisState {
+	return NewGenesisState(DefaultParams(), Headers{})
+}
+
+// ValidateGenesis checks that the genesis state is consistent
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	for _, header := range data.Headers {
+		if header.Hash == "" || header.ReceiptsRoot == "" {
+			return fmt.Errorf("invalid header %d", header.Number)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Header is the part of an ethereum block header the light client keeps, with the total difficulty of its chain.
+// A header is only approved once validators with the consensus power of the oracle have submitted it; until then it
+// cannot become the head of the best chain.
+type Header struct {
+	Hash             string  `json:"hash"`
+	ParentHash       string  `json:"parent_hash"`
+	Number           uint64  `json:"number"`
+	ReceiptsRoot     string  `json:"receipts_root"`
+	TransactionsRoot string  `json:"transactions_root"`
+	Time             uint64  `json:"time"`
+	Difficulty       sdk.Int `json:"difficulty"`
+	TotalDifficulty  sdk.Int `json:"total_difficulty"`
+	Approved         bool    `json:"approved"`
+}
+
+// NewHeader returns the Header of an ethereum header
+func NewHeader(header *ethtypes.Header, totalDifficulty sdk.Int) Header {
+	return Header{
+		Hash:             header.Hash().Hex(),
+		ParentHash:       header.ParentHash.Hex(),
+		Number:           header.Number.Uint64(),
+		ReceiptsRoot:     header.ReceiptHash.Hex(),
+		TransactionsRoot: header.TxHash.Hex(),
+		Time:             header.Time,
+		Difficulty:       sdk.NewIntFromBigInt(header.Difficulty),
+		TotalDifficulty:  totalDifficulty,
+	}
+}
+
+// DecodeHeader decodes an RLP encoded ethereum header
+func DecodeHeader(bz []byte) (*ethtypes.Header, error) {
+	var header ethtypes.Header
+	if err := rlp.DecodeBytes(bz, &header); err != nil {
+		return nil, err
+	}
+	if header.Number == nil || header.Difficulty == nil {
+		return nil, fmt.Errorf("header is missing its number or difficulty")
+	}
+	return &header, nil
+}
+
+// IsBetterThan returns whether the chain ending at the header is preferred to the one ending at other: the one
+// with the most total difficulty, then the longest
+func (header Header) IsBetterThan(other Header) bool {
+	if !header.TotalDifficulty.Equal(other.TotalDifficulty) {
+		return header.TotalDifficulty.GT(other.TotalDifficulty)
+	}
+	return header.Number > other.Number
+}
+
+func (header Header) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Number:           %d
+Hash:             %s
+Parent Hash:      %s
+Receipts Root:    %s
+Tx Root:          %s
+Time:             %d
+Difficulty:       %s
+Total Difficulty: %s
+Approved:         %t`, header.Number, header.Hash, header.ParentHash, header.ReceiptsRoot,
+		header.TransactionsRoot, header.Time, header.Difficulty, header.TotalDifficulty, header.Approved))
+}
+
+// Headers is a list of Header
+type Headers []Header
+
+func (headers Headers) String() string {
+	out := make([]string, len(headers))
+	for i, header := range headers {
+		out[i] = header.String()
+	}
+	return strings.Join(out, "\n")
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	gethCommon "github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName is the name of the ethereum headers module
+	ModuleName = "ethheaders"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// QuerierRoute is the querier route for the ethereum headers module
+	QuerierRoute = ModuleName
+
+	// RouterKey is the msg router key for the ethereum headers module
+	RouterKey = ModuleName
+
+	// DefaultParamspace is the paramspace of the ethereum headers module
+	DefaultParamspace = ModuleName
+
+	// ProphecyIDPrefix sets the oracle prophecies that approve headers apart from the other prophecies
+	ProphecyIDPrefix = "ethheader-"
+)
+
+var (
+	// HeaderPrefix is the prefix for the stored ethereum headers, by hash
+	HeaderPrefix = []byte{0x00}
+
+	// CanonicalPrefix is the prefix for the hashes of the headers on the best chain, by number
+	CanonicalPrefix = []byte{0x01}
+
+	// BestHeaderKey is the key under which the hash of the head of the best chain is stored
+	BestHeaderKey = []byte{0x02}
+)
+
+// GetHeaderKey returns the key under which the header with a hash is stored
+func GetHeaderKey(hash string) []byte {
+	return append(HeaderPrefix, gethCommon.HexToHash(hash).Bytes()...)
+}
+
+// GetCanonicalKey returns the key under which the hash of the best chain's header at a number is stored
+func GetCanonicalKey(number uint64) []byte {
+	return append(CanonicalPrefix, sdk.Uint64ToBigEndian(number)...)
+}
+
+// GetProphecyID returns the id of the oracle prophecy that approves the header with a hash
+func GetProphecyID(hash string) string {
+	return ProphecyIDPrefix + gethCommon.HexToHash(hash).Hex()
+}
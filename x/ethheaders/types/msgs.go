@@ -0,0 +1,62 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxHeadersPerMsg is the largest number of headers a single message can submit
+const MaxHeadersPerMsg = 100
+
+// MsgSubmitHeaders defines a message to submit RLP encoded ethereum headers to the light client, each one a child
+// of a stored header or of the one before it in the message
+type MsgSubmitHeaders struct {
+	Submitter sdk.AccAddress `json:"submitter"`
+	Headers   [][]byte       `json:"headers"`
+}
+
+// NewMsgSubmitHeaders is a constructor function for MsgSubmitHeaders
+func NewMsgSubmitHeaders(submitter sdk.AccAddress, headers [][]byte) MsgSubmitHeaders {
+	return MsgSubmitHeaders{
+		Submitter: submitter,
+		Headers:   headers,
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgSubmitHeaders) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgSubmitHeaders) Type() string { return "submit_headers" }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgSubmitHeaders) ValidateBasic() sdk.Error {
+	if msg.Submitter.Empty() {
+		return sdk.ErrInvalidAddress(msg.Submitter.String())
+	}
+	if len(msg.Headers) == 0 || len(msg.Headers) > MaxHeadersPerMsg {
+		return ErrInvalidHeader(DefaultCodespace, fmt.Sprintf("must submit between 1 and %d headers", MaxHeadersPerMsg))
+	}
+	for _, bz := range msg.Headers {
+		if _, err := DecodeHeader(bz); err != nil {
+			return ErrInvalidHeader(DefaultCodespace, err.Error())
+		}
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSubmitHeaders) GetSignBytes() []byte {
+	b, err := json.Marshal(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSubmitHeaders) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Submitter}
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// Consensus rules the submitted headers are validated against
+const (
+	// ConsensusRuleProofOfWork accepts headers from anyone whose difficulty follows the homestead adjustment bounds
+	ConsensusRuleProofOfWork = "pow"
+
+	// ConsensusRuleProofOfStake accepts headers without difficulty, submitted by bonded validators
+	ConsensusRuleProofOfStake = "pos"
+
+	// DifficultyBoundDivisor bounds how much the difficulty of a proof of work header can change from its parent's
+	DifficultyBoundDivisor int64 = 2048
+
+	// DefaultConfirmations is the default number of headers that must be built on a header before its receipts
+	// can be proven
+	DefaultConfirmations uint64 = 12
+)
+
+// Parameter store keys
+var (
+	KeyConsensusRule     = []byte("ConsensusRule")
+	KeyMinimumDifficulty = []byte("MinimumDifficulty")
+	KeyConfirmations     = []byte("Confirmations")
+)
+
+var _ params.ParamSet = &Params{}
+
+// Params defines the parameters of the ethheaders module
+type Params struct {
+	// ConsensusRule is the rule submitted headers are validated against, pow or pos
+	ConsensusRule string `json:"consensus_rule"`
+	// MinimumDifficulty is the lowest difficulty of a proof of work header
+	MinimumDifficulty sdk.Int `json:"minimum_difficulty"`
+	// Confirmations is the number of headers that must be built on a header before its receipts can be proven
+	Confirmations uint64 `json:"confirmations"`
+}
+
+// NewParams creates a new Params object
+func NewParams(consensusRule string, minimumDifficulty sdk.Int, confirmations uint64) Params {
+	return Params{
+		ConsensusRule:     consensusRule,
+		MinimumDifficulty: minimumDifficulty,
+		Confirmations:     confirmations,
+	}
+}
+
+// DefaultParams returns params for a proof of stake chain
+func DefaultParams() Params {
+	return NewParams(ConsensusRuleProofOfStake, sdk.ZeroInt(), DefaultConfirmations)
+}
+
+// ParamKeyTable returns the key table for the ethheaders module params
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		{Key: KeyConsensusRule, Value: &p.ConsensusRule},
+		{Key: KeyMinimumDifficulty, Value: &p.MinimumDifficulty},
+		{Key: KeyConfirmations, Value: &p.Confirmations},
+	}
+}
+
+// Validate checks that the params are consistent
+func (p Params) Validate() error {
+	if p.ConsensusRule != ConsensusRuleProofOfWork && p.ConsensusRule != ConsensusRuleProofOfStake {
+		return fmt.Errorf("consensus rule must be %s or %s, is %s", ConsensusRuleProofOfWork,
+			ConsensusRuleProofOfStake, p.ConsensusRule)
+	}
+	if p.MinimumDifficulty.IsNegative() {
+		return fmt.Errorf("minimum difficulty cannot be negative, is %s", p.MinimumDifficulty)
+	}
+	return nil
+}
+
+func (p Params) String() string {
+	return strings.TrimSpace(fmt.Sprintf(`Consensus Rule:     %s
+Minimum Difficulty: %s
+Confirmations:      %d`, p.ConsensusRule, p.MinimumDifficulty, p.Confirmations))
+}
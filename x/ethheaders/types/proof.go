@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// ReceiptProof is a Merkle-Patricia proof that a receipt is in the receipts trie of a block: the trie nodes on the
+// paths to the receipts of the transactions up to TxIndex, so the logs of the block before the receipt's own can be
+// counted, and the transactions trie nodes on the path to the transaction at TxIndex
+type ReceiptProof struct {
+	BlockHash        string   `json:"block_hash"`
+	TxIndex          uint64   `json:"tx_index"`
+	Nodes            [][]byte `json:"nodes"`
+	TransactionNodes [][]byte `json:"transaction_nodes"`
+}
+
+// NewReceiptProof returns a new ReceiptProof
+func NewReceiptProof(blockHash string, txIndex uint64, nodes [][]byte, transactionNodes [][]byte) ReceiptProof {
+	return ReceiptProof{
+		BlockHash:        blockHash,
+		TxIndex:          txIndex,
+		Nodes:            nodes,
+		TransactionNodes: transactionNodes,
+	}
+}
+
+// ProvenReceipt is a receipt proven to be in a block, with the hash of its transaction and the number of logs the
+// block holds before the receipt's own
+type ProvenReceipt struct {
+	Receipt   *ethtypes.Receipt
+	TxHash    gethCommon.Hash
+	LogOffset uint64
+}
+
+// Verify checks the proof against the receipts and transactions roots of its block and returns the proven receipt
+func (proof ReceiptProof) Verify(receiptsRoot string, transactionsRoot string) (ProvenReceipt, error) {
+	var receipt ethtypes.Receipt
+	var logOffset uint64
+	for index := uint64(0); index <= proof.TxIndex; index++ {
+		value, err := verifyTrieProof(receiptsRoot, proof.Nodes, index)
+		if err != nil {
+			return ProvenReceipt{}, err
+		}
+		if value == nil {
+			return ProvenReceipt{}, fmt.Errorf("no receipt for transaction %d", index)
+		}
+		if err := receipt.UnmarshalBinary(value); err != nil {
+			return ProvenReceipt{}, err
+		}
+		if index < proof.TxIndex {
+			logOffset += uint64(len(receipt.Logs))
+		}
+	}
+	// The hash of a transaction is the hash of its encoding in the transactions trie
+	transaction, err := verifyTrieProof(transactionsRoot, proof.TransactionNodes, proof.TxIndex)
+	if err != nil {
+		return ProvenReceipt{}, err
+	}
+	if transaction == nil {
+		return ProvenReceipt{}, fmt.Errorf("no transaction %d", proof.TxIndex)
+	}
+	return ProvenReceipt{
+		Receipt:   &receipt,
+		TxHash:    crypto.Keccak256Hash(transaction),
+		LogOffset: logOffset,
+	}, nil
+}
+
+// verifyTrieProof returns the value at an index of the trie with a root, proven by the nodes
+func verifyTrieProof(root string, nodes [][]byte, index uint64) ([]byte, error) {
+	proofDB := memorydb.New()
+	for _, node := range nodes {
+		if err := proofDB.Put(crypto.Keccak256(node), node); err != nil {
+			return nil, err
+		}
+	}
+	key, err := rlp.EncodeToBytes(index)
+	if err != nil {
+		return nil, err
+	}
+	return trie.VerifyProof(gethCommon.HexToHash(root), key, proofDB)
+}
@@ -0,0 +1,14 @@
+package types
+
+// QueryHeaderParams selects a header by hash, or the best chain's header at Number when Hash is empty
+type QueryHeaderParams struct {
+	Hash   string
+	Number uint64
+}
+
+func NewQueryHeaderParams(hash string, number uint64) QueryHeaderParams {
+	return QueryHeaderParams{
+		Hash:   hash,
+		Number: number,
+	}
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+)
+
+// CreateTestAnchorHeader returns a header to trust at genesis, with the given difficulty and receipts root
+func CreateTestAnchorHeader(difficulty int64, receiptsRoot gethCommon.Hash) *ethtypes.Header {
+	return &ethtypes.Header{
+		Number:      big.NewInt(100),
+		Time:        1000,
+		Difficulty:  big.NewInt(difficulty),
+		ReceiptHash: receiptsRoot,
+	}
+}
+
+// CreateTestHeaders returns a chain of count headers built on parent, each with the given difficulty and receipts
+// root. The extra data tells apart chains built on the same parent.
+func CreateTestHeaders(parent *ethtypes.Header, count int, difficulty int64, receiptsRoot gethCommon.Hash,
+	extra byte) []*ethtypes.Header {
+	headers := make([]*ethtypes.Header, count)
+	for i := range headers {
+		headers[i] = &ethtypes.Header{
+			ParentHash:  parent.Hash(),
+			Number:      new(big.Int).Add(parent.Number, big.NewInt(1)),
+			Time:        parent.Time + 12,
+			Difficulty:  big.NewInt(difficulty),
+			ReceiptHash: receiptsRoot,
+			Extra:       []byte{extra},
+		}
+		parent = headers[i]
+	}
+	return headers
+}
+
+// EncodeTestHeaders RLP encodes headers for a MsgSubmitHeaders
+func EncodeTestHeaders(t *testing.T, headers []*ethtypes.Header) [][]byte {
+	encoded := make([][]byte, len(headers))
+	for i, header := range headers {
+		bz, err := rlp.EncodeToBytes(header)
+		require.NoError(t, err)
+		encoded[i] = bz
+	}
+	return encoded
+}
+
+// CreateTestReceiptProof builds the receipts trie of a block holding the receipts and returns its root and the
+// proof nodes of the receipts up to index
+func CreateTestReceiptProof(t *testing.T, receipts ethtypes.Receipts, index uint64) (gethCommon.Hash, [][]byte) {
+	return createTestTrieProof(t, receipts, 0, index)
+}
+
+// CreateTestTransactionProof builds the transactions trie of a block holding the transactions and returns its root
+// and the proof nodes of the transaction at index
+func CreateTestTransactionProof(t *testing.T, transactions ethtypes.Transactions, index uint64) (gethCommon.Hash, [][]byte) {
+	return createTestTrieProof(t, transactions, index, index)
+}
+
+func createTestTrieProof(t *testing.T, list ethtypes.DerivableList, from uint64, to uint64) (gethCommon.Hash, [][]byte) {
+	listTrie := trie.NewEmpty(trie.NewDatabase(memorydb.New()))
+	for i := 0; i < list.Len(); i++ {
+		key, err := rlp.EncodeToBytes(uint64(i))
+		require.NoError(t, err)
+		var value bytes.Buffer
+		list.EncodeIndex(i, &value)
+		listTrie.Update(key, value.Bytes())
+	}
+
+	proofDB := memorydb.New()
+	for index := from; index <= to; index++ {
+		key, err := rlp.EncodeToBytes(index)
+		require.NoError(t, err)
+		require.NoError(t, listTrie.Prove(key, 0, proofDB))
+	}
+
+	var nodes [][]byte
+	iterator := proofDB.NewIterator(nil, nil)
+	defer iterator.Release()
+	for iterator.Next() {
+		nodes = append(nodes, gethCommon.CopyBytes(iterator.Value()))
+	}
+	return listTrie.Hash(), nodes
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strconv"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/staking"
@@ -20,7 +22,7 @@ type Keeper struct {
 
 	codespace sdk.CodespaceType
 
-	consensusNeeded float64
+	consensusNeeded sdk.Dec
 }
 
 // NewKeeper creates new instances of the oracle Keeper
@@ -33,7 +35,7 @@ func NewKeeper(stakeKeeper staking.Keeper, storeKey sdk.StoreKey, cdc *codec.Cod
 		storeKey:        storeKey,
 		cdc:             cdc,
 		codespace:       codespace,
-		consensusNeeded: consensusNeeded,
+		consensusNeeded: sdk.MustNewDecFromStr(strconv.FormatFloat(consensusNeeded, 'f', -1, 64)),
 	}, nil
 }
 
@@ -79,7 +81,18 @@ func (k Keeper) saveProphecy(ctx sdk.Context, prophecy types.Prophecy) sdk.Error
 	return nil
 }
 
+// ProcessClaim adds a validator's claim to a prophecy and checks whether it has reached the default consensus
 func (k Keeper) ProcessClaim(ctx sdk.Context, id string, validator sdk.ValAddress, claim string) (types.Status, sdk.Error) {
+	return k.ProcessClaimWithConsensus(ctx, id, validator, claim, sdk.ZeroDec())
+}
+
+// ProcessClaimWithConsensus adds a validator's claim to a prophecy and checks whether it has reached consensus.
+// The claim can request a higher consensus than the default; the prophecy then needs the highest consensus requested
+// by any of its claims.
+func (k Keeper) ProcessClaimWithConsensus(ctx sdk.Context, id string, validator sdk.ValAddress, claim string, consensusNeeded sdk.Dec) (types.Status, sdk.Error) {
+	if consensusNeeded.IsNegative() || consensusNeeded.GT(sdk.OneDec()) {
+		return types.Status{}, types.ErrMinimumConsensusNeededInvalid(k.Codespace())
+	}
 	activeValidator := k.checkActiveValidator(ctx, validator)
 	if !activeValidator {
 		return types.Status{}, types.ErrInvalidValidator(k.Codespace())
@@ -103,6 +116,9 @@ func (k Keeper) ProcessClaim(ctx sdk.Context, id string, validator sdk.ValAddres
 		prophecy = types.NewProphecy(id)
 		prophecy.AddClaim(validator, claim)
 	}
+	if prophecy.ConsensusNeeded.IsNil() || consensusNeeded.GT(prophecy.ConsensusNeeded) {
+		prophecy.ConsensusNeeded = consensusNeeded
+	}
 	prophecy = k.processCompletion(ctx, prophecy)
 	err = k.saveProphecy(ctx, prophecy)
 	if err != nil {
@@ -123,17 +139,33 @@ func (k Keeper) checkActiveValidator(ctx sdk.Context, validatorAddress sdk.ValAd
 	return true
 }
 
+// GetConsensusProgress returns the consensus a prophecy needs and the power of the validators that have claimed it
+func (k Keeper) GetConsensusProgress(ctx sdk.Context, prophecy types.Prophecy) types.ConsensusProgress {
+	_, highestClaimPower, totalClaimsPower := prophecy.FindHighestClaim(ctx, k.stakeKeeper)
+	totalPower := k.stakeKeeper.GetLastTotalPower(ctx)
+	return types.NewConsensusProgress(k.getConsensusNeeded(prophecy), highestClaimPower, totalClaimsPower, totalPower.Int64())
+}
+
+// getConsensusNeeded returns the consensus requested for a prophecy, or the default if that is higher
+func (k Keeper) getConsensusNeeded(prophecy types.Prophecy) sdk.Dec {
+	if prophecy.ConsensusNeeded.IsNil() {
+		return k.consensusNeeded
+	}
+	return sdk.MaxDec(k.consensusNeeded, prophecy.ConsensusNeeded)
+}
+
 func (k Keeper) processCompletion(ctx sdk.Context, prophecy types.Prophecy) types.Prophecy {
 	highestClaim, highestClaimPower, totalClaimsPower := prophecy.FindHighestClaim(ctx, k.stakeKeeper)
 	totalPower := k.stakeKeeper.GetLastTotalPower(ctx)
-	highestConsensusRatio := float64(highestClaimPower) / float64(totalPower.Int64())
+	consensusNeeded := k.getConsensusNeeded(prophecy)
+	highestConsensusRatio := sdk.NewDec(highestClaimPower).QuoInt(totalPower)
 	remainingPossibleClaimPower := totalPower.Int64() - totalClaimsPower
 	highestPossibleClaimPower := highestClaimPower + remainingPossibleClaimPower
-	highestPossibleConsensusRatio := float64(highestPossibleClaimPower) / float64(totalPower.Int64())
-	if highestConsensusRatio >= k.consensusNeeded {
+	highestPossibleConsensusRatio := sdk.NewDec(highestPossibleClaimPower).QuoInt(totalPower)
+	if highestConsensusRatio.GTE(consensusNeeded) {
 		prophecy.Status.StatusText = types.SuccessStatusText
 		prophecy.Status.FinalClaim = highestClaim
-	} else if highestPossibleConsensusRatio <= k.consensusNeeded {
+	} else if highestPossibleConsensusRatio.LTE(consensusNeeded) {
 		prophecy.Status.StatusText = types.FailedStatusText
 	}
 	return prophecy
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/types"
 )
@@ -229,3 +230,43 @@ func TestNonValidator(t *testing.T) {
 	require.True(t, strings.Contains(err.Error(), "Claim must be made by actively bonded validator"))
 	require.Equal(t, status.StatusText, "")
 }
+
+func TestProphecyWithHigherConsensus(t *testing.T) {
+	ctx, _, keeper, _, validatorAddresses, _ := CreateTestKeepers(t, 0.7, []int64{2, 7, 1})
+	validator1Pow2 := validatorAddresses[0]
+	validator2Pow7 := validatorAddresses[1]
+	validator3Pow1 := validatorAddresses[2]
+
+	//The requested consensus is recorded on the prophecy
+	status, err := keeper.ProcessClaimWithConsensus(ctx, types.TestID, validator2Pow7, types.TestString, sdk.NewDecWithPrec(9, 1))
+	require.NoError(t, err)
+	require.Equal(t, status.StatusText, types.PendingStatusText)
+
+	prophecy, err := keeper.GetProphecy(ctx, types.TestID)
+	require.NoError(t, err)
+	require.True(t, prophecy.ConsensusNeeded.Equal(sdk.NewDecWithPrec(9, 1)))
+
+	progress := keeper.GetConsensusProgress(ctx, prophecy)
+	require.True(t, progress.ConsensusNeeded.Equal(sdk.NewDecWithPrec(9, 1)))
+	require.Equal(t, int64(7), progress.HighestClaimPower)
+	require.Equal(t, int64(10), progress.TotalPower)
+
+	//A later claim requesting the default consensus does not lower it
+	status, err = keeper.ProcessClaim(ctx, types.TestID, validator1Pow2, types.TestString)
+	require.NoError(t, err)
+	require.Equal(t, status.StatusText, types.SuccessStatusText)
+
+	//Without the higher consensus 70% is enough
+	status, err = keeper.ProcessClaim(ctx, types.AlternateTestID, validator2Pow7, types.TestString)
+	require.NoError(t, err)
+	require.Equal(t, status.StatusText, types.SuccessStatusText)
+
+	//A requested consensus lower than the default has no effect
+	status, err = keeper.ProcessClaimWithConsensus(ctx, "thirdID", validator3Pow1, types.TestString, sdk.NewDecWithPrec(1, 1))
+	require.NoError(t, err)
+	require.Equal(t, status.StatusText, types.PendingStatusText)
+
+	//Consensus above 1 cannot be requested
+	_, err = keeper.ProcessClaimWithConsensus(ctx, "fourthID", validator3Pow1, types.TestString, sdk.NewDec(2))
+	require.Error(t, err)
+}
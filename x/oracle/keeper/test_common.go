@@ -5,8 +5,8 @@ import (
 	"strconv"
 	"testing"
 
-	"github.com/stretchr/testify/require"
 	"github.com/pumpkinzomb/cosmos-ethereum-bridge/x/oracle/types"
+	"github.com/stretchr/testify/require"
 	"github.com/tendermint/tendermint/crypto"
 	"github.com/tendermint/tendermint/libs/log"
 
@@ -79,7 +79,7 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorPowers []
 	numValidators := len(validatorPowers)
 	validators := make([]staking.Validator, numValidators)
 	accountAddresses, valAddresses := CreateTestAddrs(len(validatorPowers))
-	publicKeys := createTestPubKeys(len(validatorPowers))
+	publicKeys := CreateTestPubKeys(len(validatorPowers))
 
 	// create the validators addresses desired and fill them with the expected amount of coins
 	for i, power := range validatorPowers {
@@ -87,7 +87,7 @@ func CreateTestKeepers(t *testing.T, consensusNeeded float64, validatorPowers []
 		pool := stakingKeeper.GetPool(ctx)
 		err := error(nil)
 		_, _, err = bankKeeper.AddCoins(ctx, accountAddresses[i], sdk.Coins{
-			{stakingKeeper.BondDenom(ctx), coins},
+			sdk.NewCoin(stakingKeeper.BondDenom(ctx), coins),
 		})
 		require.Nil(t, err)
 		pool.NotBondedTokens = pool.NotBondedTokens.Add(coins)
@@ -131,7 +131,7 @@ func CreateTestAddrs(numAddrs int) ([]sdk.AccAddress, []sdk.ValAddress) {
 }
 
 // nolint: unparam
-func createTestPubKeys(numPubKeys int) []crypto.PubKey {
+func CreateTestPubKeys(numPubKeys int) []crypto.PubKey {
 	var publicKeys []crypto.PubKey
 	var buffer bytes.Buffer
 
@@ -14,6 +14,8 @@ type (
 	Prophecy = types.Prophecy
 
 	Status = types.Status
+
+	ConsensusProgress = types.ConsensusProgress
 )
 
 var (
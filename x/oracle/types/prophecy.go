@@ -24,14 +24,16 @@ type Prophecy struct {
 	Status          Status                      `json:"status"`
 	ClaimValidators map[string][]sdk.ValAddress `json:"claim_validators"` //This is a mapping from a claim to the list of validators that made that claim
 	ValidatorClaims map[string]string           `json:"validator_claims"` //This is a mapping from a validator bech32 address to their claim
+	ConsensusNeeded sdk.Dec                     `json:"consensus_needed"` //The consensus requested for this prophecy, zero if the oracle default applies
 }
 
 // DBProphecy is what the prophecy becomes when being saved to the database. Tendermint/Amino does not support maps so we must serialize those variables into bytes.
 type DBProphecy struct {
-	ID              string `json:"id"`
-	Status          Status `json:"status"`
-	ClaimValidators []byte `json:"claim_validators"` //This is a mapping from a claim to the list of validators that made that claim
-	ValidatorClaims []byte `json:"validator_claims"` //This is a mapping from a validator bech32 address to their claim
+	ID              string  `json:"id"`
+	Status          Status  `json:"status"`
+	ClaimValidators []byte  `json:"claim_validators"` //This is a mapping from a claim to the list of validators that made that claim
+	ValidatorClaims []byte  `json:"validator_claims"` //This is a mapping from a validator bech32 address to their claim
+	ConsensusNeeded sdk.Dec `json:"consensus_needed"` //The consensus requested for this prophecy, zero if the oracle default applies
 }
 
 // SerializeForDB serializes a prophecy into a DBProphecy
@@ -54,6 +56,7 @@ func (prophecy Prophecy) SerializeForDB() (DBProphecy, error) {
 		Status:          prophecy.Status,
 		ClaimValidators: claimValidators,
 		ValidatorClaims: validatorClaims,
+		ConsensusNeeded: prophecy.ConsensusNeeded,
 	}, nil
 }
 
@@ -76,6 +79,7 @@ func (dbProphecy DBProphecy) DeserializeFromDB() (Prophecy, error) {
 		Status:          dbProphecy.Status,
 		ClaimValidators: claimValidators,
 		ValidatorClaims: validatorClaims,
+		ConsensusNeeded: dbProphecy.ConsensusNeeded,
 	}, nil
 }
 
@@ -121,6 +125,7 @@ func NewProphecy(id string) Prophecy {
 		Status:          NewStatus(PendingStatusText, ""),
 		ClaimValidators: make(map[string][]sdk.ValAddress),
 		ValidatorClaims: make(map[string]string),
+		ConsensusNeeded: sdk.ZeroDec(),
 	}
 }
 
@@ -142,3 +147,21 @@ func NewStatus(status string, finalClaim string) Status {
 		FinalClaim: finalClaim,
 	}
 }
+
+// ConsensusProgress describes how close a prophecy is to the consensus it needs
+type ConsensusProgress struct {
+	ConsensusNeeded   sdk.Dec `json:"consensus_needed"`
+	HighestClaimPower int64   `json:"highest_claim_power"`
+	TotalClaimsPower  int64   `json:"total_claims_power"`
+	TotalPower        int64   `json:"total_power"`
+}
+
+// NewConsensusProgress returns a new ConsensusProgress
+func NewConsensusProgress(consensusNeeded sdk.Dec, highestClaimPower int64, totalClaimsPower int64, totalPower int64) ConsensusProgress {
+	return ConsensusProgress{
+		ConsensusNeeded:   consensusNeeded,
+		HighestClaimPower: highestClaimPower,
+		TotalClaimsPower:  totalClaimsPower,
+		TotalPower:        totalPower,
+	}
+}